@@ -0,0 +1,3 @@
+data "cloudflare_available_plans" "example" {
+  zone_id = "0da42c8d2132a9ddaf714f9e7c920711"
+}
@@ -0,0 +1,5 @@
+data "cloudflare_workers_kv_keys" "example" {
+  account_id   = "f037e56e89293a057740de681ac9abbe"
+  namespace_id = "0f2ac74b498b48028cb68387c421e279"
+  prefix       = "session-"
+}
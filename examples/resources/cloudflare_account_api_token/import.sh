@@ -0,0 +1 @@
+$ terraform import cloudflare_account_api_token.example <account_id>/<token_id>
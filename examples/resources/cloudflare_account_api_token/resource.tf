@@ -0,0 +1,15 @@
+data "cloudflare_api_token_permission_groups" "all" {}
+
+resource "cloudflare_account_api_token" "example" {
+  account_id = "f037e56e89293a057740de681ac9abbe"
+  name       = "workers-deploy"
+
+  policies {
+    permission_groups = [
+      data.cloudflare_api_token_permission_groups.all.account["Workers Scripts Write"],
+    ]
+    resources = {
+      "com.cloudflare.api.account.f037e56e89293a057740de681ac9abbe" = "*"
+    }
+  }
+}
@@ -0,0 +1,9 @@
+resource "cloudflare_ai_gateway" "example" {
+  account_id              = "f037e56e89293a057740de681ac9abbe"
+  name                    = "my-gateway"
+  cache_ttl               = 300
+  collect_logs            = true
+  rate_limiting_interval  = 60
+  rate_limiting_limit     = 100
+  rate_limiting_technique = "sliding"
+}
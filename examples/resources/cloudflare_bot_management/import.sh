@@ -0,0 +1 @@
+$ terraform import cloudflare_bot_management.example <zone_id>
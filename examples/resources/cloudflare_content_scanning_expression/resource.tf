@@ -0,0 +1,9 @@
+resource "cloudflare_content_scanning" "example" {
+  zone_id = "0da42c8d2132a9ddaf714f9e7c920711"
+  enabled = true
+}
+
+resource "cloudflare_content_scanning_expression" "example" {
+  zone_id = cloudflare_content_scanning.example.zone_id
+  payload = "lookup_json_string(http.request.body.raw, \"file\")"
+}
@@ -0,0 +1,18 @@
+resource "cloudflare_dns_records_batch" "example" {
+  zone_id = "0da42c8d2132a9ddaf714f9e7c920711"
+
+  record {
+    name    = "www"
+    type    = "A"
+    value   = "192.0.2.1"
+    proxied = true
+  }
+
+  record {
+    name     = "mail"
+    type     = "MX"
+    value    = "mx.example.com"
+    ttl      = 3600
+    priority = 10
+  }
+}
@@ -0,0 +1 @@
+$ terraform import cloudflare_leaked_credential_check_rule.example <zone_id>/<rule_id>
@@ -0,0 +1 @@
+$ terraform import cloudflare_origin_cache_control.example <zone_id>
@@ -0,0 +1 @@
+$ terraform import cloudflare_origin_error_page_pass_thru.example <zone_id>
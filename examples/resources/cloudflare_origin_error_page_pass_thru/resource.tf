@@ -0,0 +1,4 @@
+resource "cloudflare_origin_error_page_pass_thru" "example" {
+  zone_id = "0da42c8d2132a9ddaf714f9e7c920711"
+  value   = "on"
+}
@@ -70,6 +70,9 @@ resource "cloudflare_pages_project" "deployment_configs" {
         ENVIRONMENT = "production"
         OTHER_VALUE = "other value"
       }
+      secrets = {
+        TURNSTILE_SECRET = var.turnstile_secret
+      }
       kv_namespaces = {
         KV_BINDING_1 = "5eb63bbbe01eeed093cb22bb8f5acdc3"
         KV_BINDING_2 = "3cdca5f8bb22bc390deee10ebbb36be5"
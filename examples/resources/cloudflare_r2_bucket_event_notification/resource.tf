@@ -0,0 +1,16 @@
+resource "cloudflare_r2_bucket_event_notification" "example" {
+  account_id  = "f037e56e89293a057740de681ac9abbe"
+  bucket_name = "my-bucket"
+  queue_id    = "1b0c8b4b2d344b4e8a7c9e5d3f2a1b0c"
+
+  rule {
+    actions     = ["PutObject", "CopyObject"]
+    prefix      = "images/"
+    suffix      = ".png"
+    description = "New images"
+  }
+
+  rule {
+    actions = ["DeleteObject", "LifecycleDeletion"]
+  }
+}
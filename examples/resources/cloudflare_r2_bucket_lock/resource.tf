@@ -0,0 +1,23 @@
+resource "cloudflare_r2_bucket_lock" "example" {
+  account_id  = "f037e56e89293a057740de681ac9abbe"
+  bucket_name = "my-bucket"
+
+  rules {
+    id     = "audit-logs"
+    prefix = "audit/"
+
+    condition {
+      type            = "Age"
+      max_age_seconds = 31536000
+    }
+  }
+
+  rules {
+    id = "legal-hold"
+
+    condition {
+      type = "Date"
+      date = "2030-01-01T00:00:00Z"
+    }
+  }
+}
@@ -0,0 +1 @@
+$ terraform import cloudflare_r2_custom_domain.example <account_id>/<bucket_name>/<domain>
@@ -0,0 +1,7 @@
+resource "cloudflare_r2_custom_domain" "example" {
+  account_id  = "f037e56e89293a057740de681ac9abbe"
+  bucket_name = "my-bucket"
+  domain      = "assets.example.com"
+  zone_id     = "0da42c8d2132a9ddaf714f9e7c920711"
+  min_tls     = "1.2"
+}
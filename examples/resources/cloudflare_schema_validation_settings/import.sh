@@ -0,0 +1 @@
+$ terraform import cloudflare_schema_validation_settings.example <zone_id>
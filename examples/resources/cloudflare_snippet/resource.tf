@@ -0,0 +1,5 @@
+resource "cloudflare_snippet" "example" {
+  zone_id = "0da42c8d2132a9ddaf714f9e7c920711"
+  name    = "add_security_headers"
+  content = file("${path.module}/snippets/add_security_headers.js")
+}
@@ -0,0 +1 @@
+$ terraform import cloudflare_sort_query_string_for_cache.example <zone_id>
@@ -0,0 +1 @@
+$ terraform import cloudflare_vectorize_index.example <account_id>/<index_name>
@@ -0,0 +1,10 @@
+resource "cloudflare_vectorize_index" "example" {
+  account_id  = "f037e56e89293a057740de681ac9abbe"
+  name        = "product-embeddings"
+  description = "Embeddings of the product catalogue"
+
+  config {
+    dimensions = 768
+    metric     = "cosine"
+  }
+}
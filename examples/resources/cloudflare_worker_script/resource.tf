@@ -9,6 +9,9 @@ resource "cloudflare_worker_script" "my_script" {
   name       = "script_1"
   content    = file("script.js")
 
+  compatibility_date  = "2023-01-01"
+  compatibility_flags = ["nodejs_compat"]
+
   kv_namespace_binding {
     name         = "MY_EXAMPLE_KV_NAMESPACE"
     namespace_id = cloudflare_workers_kv_namespace.my_namespace.id
@@ -44,4 +47,13 @@ resource "cloudflare_worker_script" "my_script" {
     name    = "MY_DATASET"
     dataset = "dataset1"
   }
+
+  observability {
+    enabled            = true
+    head_sampling_rate = 0.1
+  }
+
+  tail_consumers {
+    service = "MY_TAIL_WORKER"
+  }
 }
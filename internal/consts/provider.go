@@ -79,6 +79,15 @@ const (
 	// Default value for the maximum backoff.
 	MaximumBackoffDefault = "30"
 
+	// Schema key for the maximum concurrent requests configuration.
+	MaxConcurrentRequestsSchemaKey = "max_concurrent_requests"
+
+	// Environment variable key for the maximum concurrent requests configuration.
+	MaxConcurrentRequestsEnvVarKey = "CLOUDFLARE_MAX_CONCURRENT_REQUESTS"
+
+	// Default value for the maximum concurrent requests.
+	MaxConcurrentRequestsDefault = "10"
+
 	APIClientLoggingSchemaKey = "api_client_logging"
 	APIClientLoggingEnvVarKey = "CLOUDFLARE_API_CLIENT_LOGGING"
 
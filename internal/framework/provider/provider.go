@@ -38,18 +38,19 @@ type CloudflareProvider struct {
 
 // CloudflareProviderModel describes the provider data model.
 type CloudflareProviderModel struct {
-	APIKey            types.String `tfsdk:"api_key"`
-	APIUserServiceKey types.String `tfsdk:"api_user_service_key"`
-	Email             types.String `tfsdk:"email"`
-	MinBackOff        types.Int64  `tfsdk:"min_backoff"`
-	RPS               types.Int64  `tfsdk:"rps"`
-	AccountID         types.String `tfsdk:"account_id"`
-	APIBasePath       types.String `tfsdk:"api_base_path"`
-	APIToken          types.String `tfsdk:"api_token"`
-	Retries           types.Int64  `tfsdk:"retries"`
-	MaxBackoff        types.Int64  `tfsdk:"max_backoff"`
-	APIClientLogging  types.Bool   `tfsdk:"api_client_logging"`
-	APIHostname       types.String `tfsdk:"api_hostname"`
+	APIKey                types.String `tfsdk:"api_key"`
+	APIUserServiceKey     types.String `tfsdk:"api_user_service_key"`
+	Email                 types.String `tfsdk:"email"`
+	MinBackOff            types.Int64  `tfsdk:"min_backoff"`
+	RPS                   types.Int64  `tfsdk:"rps"`
+	AccountID             types.String `tfsdk:"account_id"`
+	APIBasePath           types.String `tfsdk:"api_base_path"`
+	APIToken              types.String `tfsdk:"api_token"`
+	Retries               types.Int64  `tfsdk:"retries"`
+	MaxBackoff            types.Int64  `tfsdk:"max_backoff"`
+	MaxConcurrentRequests types.Int64  `tfsdk:"max_concurrent_requests"`
+	APIClientLogging      types.Bool   `tfsdk:"api_client_logging"`
+	APIHostname           types.String `tfsdk:"api_hostname"`
 }
 
 func (p *CloudflareProvider) Metadata(ctx context.Context, req provider.MetadataRequest, resp *provider.MetadataResponse) {
@@ -133,6 +134,11 @@ func (p *CloudflareProvider) Schema(ctx context.Context, req provider.SchemaRequ
 				MarkdownDescription: fmt.Sprintf("Maximum backoff period in seconds after failed API calls. Alternatively, can be configured using the `%s` environment variable.", consts.MaximumBackoffEnvVarKey),
 			},
 
+			consts.MaxConcurrentRequestsSchemaKey: schema.Int64Attribute{
+				Optional:            true,
+				MarkdownDescription: fmt.Sprintf("Maximum number of resource reads that may call the API at the same time. A value of `0` disables the limit. Alternatively, can be configured using the `%s` environment variable.", consts.MaxConcurrentRequestsEnvVarKey),
+			},
+
 			consts.APIClientLoggingSchemaKey: schema.BoolAttribute{
 				Optional:            true,
 				MarkdownDescription: fmt.Sprintf("Whether to print logs from the API client (using the default log library logger). Alternatively, can be configured using the `%s` environment variable.", consts.APIClientLoggingEnvVarKey),
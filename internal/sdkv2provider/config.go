@@ -4,17 +4,21 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"io"
+	"net/http"
+	"sync"
 
 	"github.com/curtislarson/cloudflare-go"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 type Config struct {
-	Email             string
-	APIKey            string
-	APIUserServiceKey string
-	APIToken          string
-	Options           []cloudflare.Option
+	Email                 string
+	APIKey                string
+	APIUserServiceKey     string
+	APIToken              string
+	MaxConcurrentRequests int
+	Options               []cloudflare.Option
 }
 
 // Client returns a new client for accessing cloudflare.
@@ -22,12 +26,23 @@ func (c *Config) Client(ctx context.Context) (*cloudflare.API, error) {
 	var err error
 	var client *cloudflare.API
 
+	options := c.Options
+	if c.MaxConcurrentRequests > 0 {
+		// The limit lives on the client's HTTP transport so it is shared by
+		// everything using this client and goes away along with it.
+		transport := &concurrencyLimitTransport{
+			base:  http.DefaultTransport,
+			slots: make(chan struct{}, c.MaxConcurrentRequests),
+		}
+		options = append([]cloudflare.Option{cloudflare.HTTPClient(&http.Client{Transport: transport})}, c.Options...)
+	}
+
 	if c.APIUserServiceKey != "" {
-		client, err = cloudflare.NewWithUserServiceKey(c.APIUserServiceKey, c.Options...)
+		client, err = cloudflare.NewWithUserServiceKey(c.APIUserServiceKey, options...)
 	} else if c.APIToken != "" {
-		client, err = cloudflare.NewWithAPIToken(c.APIToken, c.Options...)
+		client, err = cloudflare.NewWithAPIToken(c.APIToken, options...)
 	} else if c.APIKey != "" {
-		client, err = cloudflare.New(c.APIKey, c.Email, c.Options...)
+		client, err = cloudflare.New(c.APIKey, c.Email, options...)
 	} else {
 		return nil, errors.New("no credentials detected")
 	}
@@ -39,3 +54,41 @@ func (c *Config) Client(ctx context.Context) (*cloudflare.API, error) {
 	tflog.Info(ctx, fmt.Sprintf("cloudflare Client configured for user: %s", c.Email))
 	return client, nil
 }
+
+// concurrencyLimitTransport bounds the number of API requests in flight. A
+// slot is held from sending the request until its response body is closed.
+type concurrencyLimitTransport struct {
+	base  http.RoundTripper
+	slots chan struct{}
+}
+
+func (t *concurrencyLimitTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	select {
+	case t.slots <- struct{}{}:
+	case <-req.Context().Done():
+		return nil, fmt.Errorf("timed out waiting for a free request slot: %w", req.Context().Err())
+	}
+
+	release := func() { <-t.slots }
+	resp, err := t.base.RoundTrip(req)
+	if err != nil {
+		release()
+		return nil, err
+	}
+
+	resp.Body = &releasingBody{ReadCloser: resp.Body, release: release}
+	return resp, nil
+}
+
+// releasingBody frees its request slot the first time it is closed.
+type releasingBody struct {
+	io.ReadCloser
+	once    sync.Once
+	release func()
+}
+
+func (b *releasingBody) Close() error {
+	err := b.ReadCloser.Close()
+	b.once.Do(b.release)
+	return err
+}
@@ -0,0 +1,104 @@
+package sdkv2provider
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	cloudflare "github.com/curtislarson/cloudflare-go"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConcurrencyLimitTransportBlocksUntilBodyClosed(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	client := &http.Client{Transport: &concurrencyLimitTransport{
+		base:  http.DefaultTransport,
+		slots: make(chan struct{}, 1),
+	}}
+
+	resp, err := client.Get(server.URL)
+	assert.NoError(t, err)
+
+	done := make(chan struct{})
+	go func() {
+		resp, err := client.Get(server.URL)
+		if assert.NoError(t, err) {
+			resp.Body.Close()
+		}
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		t.Fatal("sent a request while the limit was reached")
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	resp.Body.Close()
+	// Closing the body again must not free a slot held by another request.
+	resp.Body.Close()
+
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("request was not sent after the previous response was closed")
+	}
+}
+
+func TestConcurrencyLimitTransportContextCancelled(t *testing.T) {
+	t.Parallel()
+
+	transport := &concurrencyLimitTransport{
+		base:  http.DefaultTransport,
+		slots: make(chan struct{}, 1),
+	}
+	transport.slots <- struct{}{}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, "http://localhost", nil)
+	assert.NoError(t, err)
+
+	_, err = transport.RoundTrip(req)
+	assert.ErrorIs(t, err, context.Canceled)
+	assert.ErrorContains(t, err, "timed out waiting for a free request slot")
+}
+
+func TestConfigClientLimitsConcurrentRequests(t *testing.T) {
+	t.Parallel()
+
+	release := make(chan struct{})
+	inFlight := make(chan struct{}, 10)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		inFlight <- struct{}{}
+		<-release
+		w.Header().Set("content-type", "application/json")
+		w.Write([]byte(`{"success": true, "errors": [], "messages": [], "result": {}}`))
+	}))
+	defer server.Close()
+	defer close(release)
+
+	config := Config{APIToken: "token", MaxConcurrentRequests: 1, Options: []cloudflare.Option{cloudflare.BaseURL(server.URL), cloudflare.UsingRateLimit(1000)}}
+	client, err := config.Client(context.Background())
+	assert.NoError(t, err)
+
+	for i := 0; i < 2; i++ {
+		go client.Raw(context.Background(), http.MethodGet, "/zones", nil, nil) //nolint:errcheck
+	}
+
+	<-inFlight
+	select {
+	case <-inFlight:
+		t.Fatal("sent a second request while the limit was reached")
+	case <-time.After(50 * time.Millisecond):
+	}
+}
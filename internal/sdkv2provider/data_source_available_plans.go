@@ -0,0 +1,100 @@
+package sdkv2provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cloudflare/terraform-provider-cloudflare/internal/consts"
+	"github.com/curtislarson/cloudflare-go"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceCloudflareAvailablePlans() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceCloudflareAvailablePlansRead,
+
+		Schema: map[string]*schema.Schema{
+			consts.ZoneIDSchemaKey: {
+				Description: "The zone identifier to target for the resource.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"plans": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Rate plans available for the zone.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Identifier of the rate plan.",
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Name of the rate plan.",
+						},
+						"price": {
+							Type:        schema.TypeFloat,
+							Computed:    true,
+							Description: "Price of the rate plan.",
+						},
+						"currency": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Currency of the rate plan price.",
+						},
+						"frequency": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "How often the rate plan is billed.",
+						},
+						"is_subscribed": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Whether the zone is currently subscribed to the rate plan.",
+						},
+					},
+				},
+			},
+		},
+		Description: "Use this data source to look up the rate plans available for a zone.",
+	}
+}
+
+func dataSourceCloudflareAvailablePlansRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	zoneID := d.Get(consts.ZoneIDSchemaKey).(string)
+
+	tflog.Debug(ctx, fmt.Sprintf("Reading available plans for zone %q", zoneID))
+
+	zonePlans, err := client.AvailableZonePlans(ctx, zoneID)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error listing available plans for zone %q: %w", zoneID, err))
+	}
+
+	planIDs := make([]string, 0, len(zonePlans))
+	plans := make([]interface{}, 0, len(zonePlans))
+	for _, plan := range zonePlans {
+		plans = append(plans, map[string]interface{}{
+			"id":            plan.ID,
+			"name":          plan.Name,
+			"price":         float64(plan.Price),
+			"currency":      plan.Currency,
+			"frequency":     plan.Frequency,
+			"is_subscribed": plan.IsSubscribed,
+		})
+		planIDs = append(planIDs, plan.ID)
+	}
+
+	if err := d.Set("plans", plans); err != nil {
+		return diag.FromErr(fmt.Errorf("error setting available plans: %w", err))
+	}
+
+	d.SetId(stringListChecksum(append([]string{zoneID}, planIDs...)))
+
+	return nil
+}
@@ -0,0 +1,43 @@
+package sdkv2provider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccCloudflareAvailablePlans(t *testing.T) {
+	zoneID := os.Getenv("CLOUDFLARE_ZONE_ID")
+	rnd := generateRandomResourceName()
+	name := fmt.Sprintf("data.cloudflare_available_plans.%s", rnd)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareAvailablePlansConfig(zoneID, rnd),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(name, "id"),
+					resource.TestCheckResourceAttr(name, "zone_id", zoneID),
+					resource.TestCheckResourceAttrSet(name, "plans.#"),
+					resource.TestCheckResourceAttrSet(name, "plans.0.id"),
+					resource.TestCheckResourceAttrSet(name, "plans.0.name"),
+					resource.TestCheckTypeSetElemNestedAttrs(name, "plans.*", map[string]string{
+						"is_subscribed": "true",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func testAccCloudflareAvailablePlansConfig(zoneID, name string) string {
+	return fmt.Sprintf(`
+data "cloudflare_available_plans" "%[1]s" {
+  zone_id = "%[2]s"
+}
+`, name, zoneID)
+}
@@ -4,6 +4,8 @@ import (
 	"context"
 	"fmt"
 	"regexp"
+	"sync"
+	"time"
 
 	"github.com/cloudflare/terraform-provider-cloudflare/internal/consts"
 	cloudflare "github.com/curtislarson/cloudflare-go"
@@ -13,9 +15,83 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
+var wafGroupModes = []string{"on", "off"}
+
+// wafPackageCache holds the WAF packages already listed for each zone. One is
+// created with each cloudflare_waf_groups data source so it lives as long as
+// the configured provider, and packages rarely change so every data source in
+// a run shares a single lookup per zone. The provider meta is the API client
+// itself, so the cache remembers the client it was filled with and starts
+// over when the provider is configured again.
+type wafPackageCache struct {
+	mu     sync.Mutex
+	client *cloudflare.API
+	zones  map[string]*wafPackageCacheEntry
+}
+
+type wafPackageCacheEntry struct {
+	// lock is held while the packages are listed. It's a channel rather than a
+	// mutex so readers stop waiting once their context is done.
+	lock     chan struct{}
+	packages []cloudflare.WAFPackage
+	fetched  bool
+}
+
+func newWAFPackageCache() *wafPackageCache {
+	return &wafPackageCache{zones: make(map[string]*wafPackageCacheEntry)}
+}
+
+// list returns the WAF packages of the zone, only calling the API the first
+// time the zone is looked up. Failed lookups aren't cached so later reads can
+// retry them.
+func (c *wafPackageCache) list(ctx context.Context, client *cloudflare.API, zoneID string) ([]cloudflare.WAFPackage, error) {
+	c.mu.Lock()
+	if c.client != client {
+		c.client = client
+		c.zones = make(map[string]*wafPackageCacheEntry)
+	}
+	entry, ok := c.zones[zoneID]
+	if !ok {
+		entry = &wafPackageCacheEntry{lock: make(chan struct{}, 1)}
+		c.zones[zoneID] = entry
+	}
+	c.mu.Unlock()
+
+	select {
+	case entry.lock <- struct{}{}:
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+	defer func() { <-entry.lock }()
+
+	if entry.fetched {
+		tflog.Debug(ctx, fmt.Sprintf("Using cached WAF Packages for zone %q", zoneID))
+		return entry.packages, nil
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Reading WAF Packages"))
+	packages, err := client.ListWAFPackages(ctx, zoneID)
+	if err != nil {
+		return nil, err
+	}
+
+	entry.packages = packages
+	entry.fetched = true
+
+	return packages, nil
+}
+
 func dataSourceCloudflareWAFGroups() *schema.Resource {
+	packages := newWAFPackageCache()
+
 	return &schema.Resource{
-		ReadContext: dataSourceCloudflareWAFGroupsRead,
+		ReadWithoutTimeout: func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			return dataSourceCloudflareWAFGroupsRead(ctx, d, meta, packages)
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(2 * time.Minute),
+		},
 
 		Schema: map[string]*schema.Schema{
 			consts.ZoneIDSchemaKey: {
@@ -29,6 +105,13 @@ func dataSourceCloudflareWAFGroups() *schema.Resource {
 				Optional: true,
 			},
 
+			"fail_if_empty": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether to return an error when no WAF Rule Groups match the filter.",
+			},
+
 			"filter": {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -39,10 +122,25 @@ func dataSourceCloudflareWAFGroups() *schema.Resource {
 							Type:     schema.TypeString,
 							Optional: true,
 						},
+						"name_exact": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+						"case_insensitive": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
 						"mode": {
 							Type:         schema.TypeString,
 							Optional:     true,
-							ValidateFunc: validation.StringInSlice([]string{"on", "off"}, false),
+							ValidateFunc: validation.StringInSlice(wafGroupModes, false),
+						},
+						"mode_not": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringInSlice(wafGroupModes, false),
 						},
 					},
 				},
@@ -84,14 +182,33 @@ func dataSourceCloudflareWAFGroups() *schema.Resource {
 					},
 				},
 			},
+
+			"group_ids": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The IDs of the matched WAF Rule Groups, in the same order as `groups`.",
+			},
+
+			"total": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The number of matched WAF Rule Groups.",
+			},
 		},
 	}
 }
 
-func dataSourceCloudflareWAFGroupsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+func dataSourceCloudflareWAFGroupsRead(ctx context.Context, d *schema.ResourceData, meta interface{}, packages *wafPackageCache) diag.Diagnostics {
 	client := meta.(*cloudflare.API)
 	zoneID := d.Get(consts.ZoneIDSchemaKey).(string)
 
+	// Every request made while reading the groups shares a single deadline so
+	// zones with many packages fail with a timeout rather than hanging.
+	timeout := d.Timeout(schema.TimeoutRead)
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
 	// Prepare the filters to be applied to the search
 	filter, err := expandFilterWAFGroups(d.Get("filter"))
 	if err != nil {
@@ -103,10 +220,12 @@ func dataSourceCloudflareWAFGroupsRead(ctx context.Context, d *schema.ResourceDa
 	var pkgList []cloudflare.WAFPackage
 	if packageID == "" {
 		var err error
-		tflog.Debug(ctx, fmt.Sprintf("Reading WAF Packages"))
-		pkgList, err = client.ListWAFPackages(ctx, zoneID)
+		pkgList, err = packages.list(ctx, client, zoneID)
+		if ctx.Err() == context.DeadlineExceeded {
+			return diag.Errorf("timed out after %s listing WAF packages for zone %q", timeout, zoneID)
+		}
 		if err != nil {
-			return diag.FromErr(err)
+			return diag.Diagnostics{cloudflareErrorDiagnostic(fmt.Sprintf("error listing WAF packages for zone %q", zoneID), err)}
 		}
 	} else {
 		pkgList = append(pkgList, cloudflare.WAFPackage{ID: packageID})
@@ -117,16 +236,16 @@ func dataSourceCloudflareWAFGroupsRead(ctx context.Context, d *schema.ResourceDa
 	groupDetails := make([]interface{}, 0)
 	for _, pkg := range pkgList {
 		groupList, err := client.ListWAFGroups(ctx, zoneID, pkg.ID)
+		if ctx.Err() == context.DeadlineExceeded {
+			return diag.Errorf("timed out after %s listing WAF groups for package %q in zone %q", timeout, pkg.ID, zoneID)
+		}
 		if err != nil {
-			return diag.FromErr(err)
+			return diag.Diagnostics{cloudflareErrorDiagnostic(fmt.Sprintf("error listing WAF groups for package %q in zone %q", pkg.ID, zoneID), err)}
 		}
 
 		for _, group := range groupList {
-			if filter.Name != nil && !filter.Name.Match([]byte(group.Name)) {
-				continue
-			}
-
-			if filter.Mode != "" && filter.Mode != group.Mode {
+			if excludedBy := filter.excludedBy(group); excludedBy != "" {
+				tflog.Debug(ctx, fmt.Sprintf("Skipping WAF group %q (%s) in package %q: excluded by %s filter", group.Name, group.ID, pkg.ID, excludedBy))
 				continue
 			}
 
@@ -143,11 +262,25 @@ func dataSourceCloudflareWAFGroupsRead(ctx context.Context, d *schema.ResourceDa
 		}
 	}
 
+	if len(groupDetails) == 0 && d.Get("fail_if_empty").(bool) {
+		return diag.Errorf("no WAF groups in zone %q match the filter", zoneID)
+	}
+
 	err = d.Set("groups", groupDetails)
 	if err != nil {
 		return diag.FromErr(fmt.Errorf("error setting WAF groups: %w", err))
 	}
 
+	err = d.Set("group_ids", groupIds)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error setting WAF group IDs: %w", err))
+	}
+
+	err = d.Set("total", len(groupDetails))
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error setting WAF groups total: %w", err))
+	}
+
 	d.SetId(stringListChecksum(groupIds))
 	return nil
 }
@@ -160,9 +293,20 @@ func expandFilterWAFGroups(d interface{}) (*searchFilterWAFGroups, error) {
 	}
 
 	m := cfg[0].(map[string]interface{})
-	name, ok := m["name"]
-	if ok {
-		match, err := regexp.Compile(name.(string))
+	// The SDK sets every key of the block, so an unset name is an empty string
+	// rather than missing and must not become a pattern matching nothing.
+	name, _ := m["name"].(string)
+	if name != "" {
+		pattern := name
+		if exact, _ := m["name_exact"].(bool); exact {
+			pattern = "^(?:" + pattern + ")$"
+		}
+
+		if insensitive, _ := m["case_insensitive"].(bool); insensitive {
+			pattern = "(?i)" + pattern
+		}
+
+		match, err := regexp.Compile(pattern)
 		if err != nil {
 			return nil, err
 		}
@@ -175,10 +319,38 @@ func expandFilterWAFGroups(d interface{}) (*searchFilterWAFGroups, error) {
 		filter.Mode = mode.(string)
 	}
 
+	modeNot, ok := m["mode_not"]
+	if ok {
+		filter.ModeNot = modeNot.(string)
+	}
+
+	if filter.Mode != "" && filter.Mode == filter.ModeNot {
+		return nil, fmt.Errorf("filter mode and mode_not cannot both be %q", filter.Mode)
+	}
+
 	return filter, nil
 }
 
 type searchFilterWAFGroups struct {
-	Name *regexp.Regexp
-	Mode string
+	Name    *regexp.Regexp
+	Mode    string
+	ModeNot string
+}
+
+// excludedBy returns the name of the first filter which excludes the group,
+// or an empty string when the group matches every filter.
+func (f *searchFilterWAFGroups) excludedBy(group cloudflare.WAFGroup) string {
+	if f.Name != nil && !f.Name.MatchString(group.Name) {
+		return "name"
+	}
+
+	if f.Mode != "" && f.Mode != group.Mode {
+		return "mode"
+	}
+
+	if f.ModeNot != "" && f.ModeNot == group.Mode {
+		return "mode_not"
+	}
+
+	return ""
 }
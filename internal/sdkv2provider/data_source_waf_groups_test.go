@@ -1,13 +1,22 @@
 package sdkv2provider
 
 import (
+	"context"
 	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"os"
+	"regexp"
 	"strings"
+	"sync"
 	"testing"
+	"time"
 
+	cloudflare "github.com/curtislarson/cloudflare-go"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
 )
 
 func TestAccCloudflareWAFGroups_NoFilter(t *testing.T) {
@@ -27,6 +36,7 @@ func TestAccCloudflareWAFGroups_NoFilter(t *testing.T) {
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckCloudflareWAFGroupsDataSourceID(name),
 					resource.TestCheckResourceAttr(name, "groups.#", "30"),
+					resource.TestCheckResourceAttr(name, "total", "30"),
 				),
 			},
 		},
@@ -50,6 +60,10 @@ func TestAccCloudflareWAFGroups_MatchName(t *testing.T) {
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckCloudflareWAFGroupsDataSourceID(name),
 					resource.TestCheckResourceAttr(name, "groups.#", "20"),
+					resource.TestCheckResourceAttr(name, "group_ids.#", "20"),
+					resource.TestCheckResourceAttr(name, "total", "20"),
+					resource.TestCheckResourceAttrPair(name, "group_ids.0", name, "groups.0.id"),
+					resource.TestCheckResourceAttrPair(name, "group_ids.19", name, "groups.19.id"),
 				),
 			},
 		},
@@ -78,6 +92,339 @@ func TestAccCloudflareWAFGroups_MatchMode(t *testing.T) {
 	})
 }
 
+func TestAccCloudflareWAFGroups_SharedPackageLookup(t *testing.T) {
+	skipV1WAFTestForNonConfiguredDefaultZone(t)
+
+	t.Parallel()
+	zoneID := os.Getenv("CLOUDFLARE_ZONE_ID")
+	rnd := generateRandomResourceName()
+	on := fmt.Sprintf("data.cloudflare_waf_groups.%s_on", rnd)
+	off := fmt.Sprintf("data.cloudflare_waf_groups.%s_off", rnd)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareWAFGroupsConfig(zoneID, map[string]string{"mode": "on"}, rnd+"_on") +
+					testAccCloudflareWAFGroupsConfig(zoneID, map[string]string{"mode": "off"}, rnd+"_off"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCloudflareWAFGroupsDataSourceID(on),
+					testAccCheckCloudflareWAFGroupsDataSourceID(off),
+				),
+			},
+		},
+	})
+}
+
+func TestAccCloudflareWAFGroups_ReadTimeout(t *testing.T) {
+	skipV1WAFTestForNonConfiguredDefaultZone(t)
+
+	t.Parallel()
+	zoneID := os.Getenv("CLOUDFLARE_ZONE_ID")
+	rnd := generateRandomResourceName()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+				data "cloudflare_waf_groups" "%[1]s" {
+					zone_id = "%[2]s"
+
+					timeouts {
+						read = "1ns"
+					}
+				}`, rnd, zoneID),
+				ExpectError: regexp.MustCompile(`timed out`),
+			},
+		},
+	})
+}
+
+func TestAccCloudflareWAFGroups_FailIfEmpty(t *testing.T) {
+	skipV1WAFTestForNonConfiguredDefaultZone(t)
+
+	t.Parallel()
+	zoneID := os.Getenv("CLOUDFLARE_ZONE_ID")
+	rnd := generateRandomResourceName()
+	name := fmt.Sprintf("data.cloudflare_waf_groups.%s", rnd)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareWAFGroupsFailIfEmptyConfig(zoneID, "OWASP.*", rnd),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCloudflareWAFGroupsDataSourceID(name),
+					resource.TestCheckResourceAttr(name, "total", "20"),
+				),
+			},
+			{
+				Config:      testAccCloudflareWAFGroupsFailIfEmptyConfig(zoneID, rnd, rnd),
+				ExpectError: regexp.MustCompile(`no WAF groups in zone .* match the filter`),
+			},
+		},
+	})
+}
+
+func TestExpandFilterWAFGroups_NameExact(t *testing.T) {
+	filter, err := expandFilterWAFGroups([]interface{}{map[string]interface{}{
+		"name":       "SQL|XSS",
+		"name_exact": false,
+	}})
+	assert.NoError(t, err)
+	assert.True(t, filter.Name.MatchString("OWASP SQL Injection"))
+	assert.True(t, filter.Name.MatchString("XSS"))
+
+	filter, err = expandFilterWAFGroups([]interface{}{map[string]interface{}{
+		"name":       "SQL|XSS",
+		"name_exact": true,
+	}})
+	assert.NoError(t, err)
+	assert.False(t, filter.Name.MatchString("OWASP SQL Injection"))
+	assert.True(t, filter.Name.MatchString("SQL"))
+	assert.True(t, filter.Name.MatchString("XSS"))
+	assert.False(t, filter.Name.MatchString("XSS Attacks"))
+}
+
+func TestExpandFilterWAFGroups_CaseInsensitive(t *testing.T) {
+	filter, err := expandFilterWAFGroups([]interface{}{map[string]interface{}{
+		"name":             "owasp",
+		"case_insensitive": false,
+	}})
+	assert.NoError(t, err)
+	assert.False(t, filter.Name.MatchString("OWASP Bad Robots"))
+
+	filter, err = expandFilterWAFGroups([]interface{}{map[string]interface{}{
+		"name":             "owasp",
+		"case_insensitive": true,
+	}})
+	assert.NoError(t, err)
+	assert.True(t, filter.Name.MatchString("OWASP Bad Robots"))
+
+	filter, err = expandFilterWAFGroups([]interface{}{map[string]interface{}{
+		"name":             "owasp bad robots",
+		"name_exact":       true,
+		"case_insensitive": true,
+	}})
+	assert.NoError(t, err)
+	assert.True(t, filter.Name.MatchString("OWASP Bad Robots"))
+	assert.False(t, filter.Name.MatchString("OWASP Bad Robots Extra"))
+}
+
+func TestAccCloudflareWAFGroups_MatchModeNot(t *testing.T) {
+	skipV1WAFTestForNonConfiguredDefaultZone(t)
+
+	t.Parallel()
+	zoneID := os.Getenv("CLOUDFLARE_ZONE_ID")
+	rnd := generateRandomResourceName()
+	name := fmt.Sprintf("data.cloudflare_waf_groups.%s", rnd)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareWAFGroupsConfig(zoneID, map[string]string{"mode_not": "off"}, rnd),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCloudflareWAFGroupsDataSourceID(name),
+				),
+			},
+			{
+				Config:      testAccCloudflareWAFGroupsConfig(zoneID, map[string]string{"mode": "on", "mode_not": "on"}, rnd),
+				ExpectError: regexp.MustCompile(`filter mode and mode_not cannot both be "on"`),
+			},
+		},
+	})
+}
+
+func TestExpandFilterWAFGroups_ModeNot(t *testing.T) {
+	filter, err := expandFilterWAFGroups([]interface{}{map[string]interface{}{
+		"mode":     "on",
+		"mode_not": "off",
+	}})
+	assert.NoError(t, err)
+	assert.Equal(t, "on", filter.Mode)
+	assert.Equal(t, "off", filter.ModeNot)
+
+	_, err = expandFilterWAFGroups([]interface{}{map[string]interface{}{
+		"mode":     "off",
+		"mode_not": "off",
+	}})
+	assert.EqualError(t, err, `filter mode and mode_not cannot both be "off"`)
+}
+
+func TestSearchFilterWAFGroupsExcludedBy(t *testing.T) {
+	filter, err := expandFilterWAFGroups([]interface{}{map[string]interface{}{
+		"name":     "OWASP",
+		"mode":     "on",
+		"mode_not": "",
+	}})
+	assert.NoError(t, err)
+
+	assert.Equal(t, "", filter.excludedBy(cloudflare.WAFGroup{Name: "OWASP Bad Robots", Mode: "on"}))
+	assert.Equal(t, "name", filter.excludedBy(cloudflare.WAFGroup{Name: "Cloudflare Specials", Mode: "on"}))
+	assert.Equal(t, "mode", filter.excludedBy(cloudflare.WAFGroup{Name: "OWASP Bad Robots", Mode: "off"}))
+
+	filter, err = expandFilterWAFGroups([]interface{}{map[string]interface{}{
+		"mode_not": "off",
+	}})
+	assert.NoError(t, err)
+
+	assert.Equal(t, "", filter.excludedBy(cloudflare.WAFGroup{Name: "OWASP Bad Robots", Mode: "on"}))
+	assert.Equal(t, "mode_not", filter.excludedBy(cloudflare.WAFGroup{Name: "OWASP Bad Robots", Mode: "off"}))
+}
+
+func TestExpandFilterWAFGroups_AllKeysSet(t *testing.T) {
+	filter, err := expandFilterWAFGroups([]interface{}{map[string]interface{}{
+		"name":             "",
+		"name_exact":       true,
+		"case_insensitive": true,
+		"mode":             "on",
+		"mode_not":         "",
+	}})
+	assert.NoError(t, err)
+	assert.Nil(t, filter.Name)
+
+	assert.Equal(t, "", filter.excludedBy(cloudflare.WAFGroup{Name: "OWASP Bad Robots", Mode: "on"}))
+	assert.Equal(t, "mode", filter.excludedBy(cloudflare.WAFGroup{Name: "OWASP Bad Robots", Mode: "off"}))
+
+	filter, err = expandFilterWAFGroups([]interface{}{map[string]interface{}{
+		"name":             "owasp bad robots",
+		"name_exact":       true,
+		"case_insensitive": true,
+		"mode":             "",
+		"mode_not":         "",
+	}})
+	assert.NoError(t, err)
+
+	assert.Equal(t, "", filter.excludedBy(cloudflare.WAFGroup{Name: "OWASP Bad Robots", Mode: "on"}))
+	assert.Equal(t, "name", filter.excludedBy(cloudflare.WAFGroup{Name: "OWASP Bad Robots 2", Mode: "on"}))
+}
+
+// testWAFGroupsAPI serves a WAF package with a single group for every zone
+// and counts the requests made for each path.
+type testWAFGroupsAPI struct {
+	mu    sync.Mutex
+	calls map[string]int
+	block chan struct{}
+}
+
+func (a *testWAFGroupsAPI) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	a.mu.Lock()
+	a.calls[r.URL.Path]++
+	a.mu.Unlock()
+
+	if a.block != nil {
+		<-a.block
+	}
+
+	result := `[{"id": "package", "name": "OWASP ModSecurity Core Rule Set"}]`
+	if strings.HasSuffix(r.URL.Path, "/groups") {
+		result = `[{"id": "group", "name": "OWASP Bad Robots", "mode": "on"}]`
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	fmt.Fprintf(w, `{"success": true, "errors": [], "messages": [], "result": %s, "result_info": {"page": 1, "total_pages": 1}}`, result)
+}
+
+func (a *testWAFGroupsAPI) count(path string) int {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	return a.calls[path]
+}
+
+func testWAFGroupsClient(t *testing.T, api *testWAFGroupsAPI) *cloudflare.API {
+	server := httptest.NewServer(api)
+	t.Cleanup(server.Close)
+
+	config := Config{
+		APIToken: "token",
+		Options:  []cloudflare.Option{cloudflare.BaseURL(server.URL), cloudflare.UsingRateLimit(1000)},
+	}
+	client, err := config.Client(context.Background())
+	assert.NoError(t, err)
+
+	return client
+}
+
+func TestWAFPackageCacheList(t *testing.T) {
+	api := &testWAFGroupsAPI{calls: make(map[string]int)}
+	client := testWAFGroupsClient(t, api)
+
+	cache := newWAFPackageCache()
+	for i := 0; i < 3; i++ {
+		packages, err := cache.list(context.Background(), client, "zone")
+		assert.NoError(t, err)
+		assert.Len(t, packages, 1)
+	}
+	assert.Equal(t, 1, api.count("/zones/zone/firewall/waf/packages"))
+
+	_, err := cache.list(context.Background(), client, "other")
+	assert.NoError(t, err)
+	assert.Equal(t, 1, api.count("/zones/other/firewall/waf/packages"))
+
+	_, err = newWAFPackageCache().list(context.Background(), client, "zone")
+	assert.NoError(t, err)
+	assert.Equal(t, 2, api.count("/zones/zone/firewall/waf/packages"))
+
+	// Configuring the provider again replaces the client, which must not see
+	// the packages listed with the previous one.
+	_, err = cache.list(context.Background(), testWAFGroupsClient(t, api), "zone")
+	assert.NoError(t, err)
+	assert.Equal(t, 3, api.count("/zones/zone/firewall/waf/packages"))
+}
+
+func TestWAFPackageCacheListContextDone(t *testing.T) {
+	api := &testWAFGroupsAPI{calls: make(map[string]int), block: make(chan struct{})}
+	client := testWAFGroupsClient(t, api)
+	cache := newWAFPackageCache()
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		_, err := cache.list(context.Background(), client, "zone")
+		assert.NoError(t, err)
+	}()
+
+	for api.count("/zones/zone/firewall/waf/packages") == 0 {
+		time.Sleep(time.Millisecond)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	_, err := cache.list(ctx, client, "zone")
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.Less(t, time.Since(start), time.Second)
+
+	close(api.block)
+	<-done
+}
+
+func TestDataSourceCloudflareWAFGroupsReadSharesPackages(t *testing.T) {
+	api := &testWAFGroupsAPI{calls: make(map[string]int)}
+	client := testWAFGroupsClient(t, api)
+	r := dataSourceCloudflareWAFGroups()
+
+	for _, mode := range []string{"on", "off"} {
+		d := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{
+			"zone_id": "zone",
+			"filter":  []interface{}{map[string]interface{}{"mode": mode}},
+		})
+
+		diags := r.ReadWithoutTimeout(context.Background(), d, client)
+		assert.False(t, diags.HasError(), "%v", diags)
+	}
+
+	assert.Equal(t, 1, api.count("/zones/zone/firewall/waf/packages"))
+	assert.Equal(t, 2, api.count("/zones/zone/firewall/waf/packages/package/groups"))
+}
+
 func testAccCheckCloudflareWAFGroupsDataSourceID(n string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		all := s.RootModule().Resources
@@ -108,3 +455,15 @@ func testAccCloudflareWAFGroupsConfig(zoneID string, filters map[string]string,
 					}
 				}`, name, zoneID, strings.Join(filters_str, "\n\t\t\t\t"))
 }
+
+func testAccCloudflareWAFGroupsFailIfEmptyConfig(zoneID, filterName, name string) string {
+	return fmt.Sprintf(`
+				data "cloudflare_waf_groups" "%[1]s" {
+					zone_id       = "%[2]s"
+					fail_if_empty = true
+
+					filter {
+						name = "%[3]s"
+					}
+				}`, name, zoneID, filterName)
+}
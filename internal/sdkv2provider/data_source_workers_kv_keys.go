@@ -0,0 +1,70 @@
+package sdkv2provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/cloudflare/terraform-provider-cloudflare/internal/consts"
+	"github.com/curtislarson/cloudflare-go"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceCloudflareWorkersKVKeysRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	accountID := d.Get(consts.AccountIDSchemaKey).(string)
+	namespaceID := d.Get("namespace_id").(string)
+
+	params := cloudflare.ListWorkersKVsParams{
+		NamespaceID: namespaceID,
+		Prefix:      d.Get("prefix").(string),
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Reading Workers KV keys in namespace %q", namespaceID))
+
+	keys := make([]cloudflare.StorageKey, 0)
+	for {
+		res, err := client.ListWorkersKVKeys(ctx, cloudflare.AccountIdentifier(accountID), params)
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("error listing Workers KV keys in namespace %q: %w", namespaceID, err))
+		}
+
+		keys = append(keys, res.Result...)
+
+		if res.ResultInfo.Cursor == "" {
+			break
+		}
+		params.Cursor = res.ResultInfo.Cursor
+	}
+
+	keyNames := make([]string, 0, len(keys))
+	keyDetails := make([]interface{}, 0, len(keys))
+
+	for _, k := range keys {
+		metadata := ""
+		if k.Metadata != nil {
+			b, err := json.Marshal(k.Metadata)
+			if err != nil {
+				return diag.FromErr(fmt.Errorf("failed to marshal metadata of Workers KV key %q: %w", k.Name, err))
+			}
+			metadata = string(b)
+		}
+
+		keyDetails = append(keyDetails, map[string]interface{}{
+			"name":       k.Name,
+			"expiration": k.Expiration,
+			"metadata":   metadata,
+		})
+		keyNames = append(keyNames, k.Name)
+	}
+
+	err := d.Set("keys", keyDetails)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error setting keys: %w", err))
+	}
+
+	d.SetId(stringListChecksum(append(keyNames, namespaceID)))
+	return nil
+}
@@ -0,0 +1,68 @@
+package sdkv2provider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccCloudflareWorkersKVKeys(t *testing.T) {
+	rnd := generateRandomResourceName()
+	name := fmt.Sprintf("data.cloudflare_workers_kv_keys.%s", rnd)
+	accountID := os.Getenv("CLOUDFLARE_ACCOUNT_ID")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAccount(t)
+		},
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareWorkersKVKeysConfig(rnd, accountID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(name, "id"),
+					resource.TestCheckResourceAttr(name, "keys.#", "1"),
+					resource.TestCheckResourceAttr(name, "keys.0.name", rnd+"-included"),
+					resource.TestCheckResourceAttr(name, "keys.0.expiration", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCloudflareWorkersKVKeysConfig(rnd, accountID string) string {
+	return fmt.Sprintf(`
+resource "cloudflare_workers_kv_namespace" "%[1]s" {
+	account_id = "%[2]s"
+	title      = "%[1]s"
+}
+
+resource "cloudflare_workers_kv" "%[1]s_included" {
+	account_id   = "%[2]s"
+	namespace_id = cloudflare_workers_kv_namespace.%[1]s.id
+	key          = "%[1]s-included"
+	value        = "included"
+}
+
+resource "cloudflare_workers_kv" "%[1]s_excluded" {
+	account_id   = "%[2]s"
+	namespace_id = cloudflare_workers_kv_namespace.%[1]s.id
+	key          = "excluded-%[1]s"
+	value        = "excluded"
+}
+
+data "cloudflare_workers_kv_keys" "%[1]s" {
+	account_id   = "%[2]s"
+	namespace_id = cloudflare_workers_kv_namespace.%[1]s.id
+	prefix       = "%[1]s-"
+
+	depends_on = [
+		cloudflare_workers_kv.%[1]s_included,
+		cloudflare_workers_kv.%[1]s_excluded,
+	]
+}
+`, rnd, accountID)
+}
@@ -0,0 +1,121 @@
+package sdkv2provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+
+	"github.com/cloudflare/terraform-provider-cloudflare/internal/consts"
+	"github.com/curtislarson/cloudflare-go"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceCloudflareZoneSettings() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceCloudflareZoneSettingsRead,
+
+		Schema: map[string]*schema.Schema{
+			consts.ZoneIDSchemaKey: {
+				Description: "The zone identifier to target for the resource.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"settings": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Every setting of the zone, sorted by setting identifier.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Identifier of the zone setting.",
+						},
+						"value": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Value of the zone setting. Values that are not strings or numbers are JSON encoded.",
+						},
+						"editable": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Whether the setting can be modified for the zone's current plan.",
+						},
+						"modified_on": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "When the setting was last modified.",
+						},
+					},
+				},
+			},
+		},
+		Description: "Use this data source to look up every setting of a zone.",
+	}
+}
+
+func dataSourceCloudflareZoneSettingsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	zoneID := d.Get(consts.ZoneIDSchemaKey).(string)
+
+	tflog.Debug(ctx, fmt.Sprintf("Reading Zone Settings for zone %q", zoneID))
+
+	zoneSettings, err := client.ZoneSettings(ctx, zoneID)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error reading zone settings for zone %q: %w", zoneID, err))
+	}
+
+	sort.SliceStable(zoneSettings.Result, func(i, j int) bool {
+		return zoneSettings.Result[i].ID < zoneSettings.Result[j].ID
+	})
+
+	settingIDs := make([]string, 0, len(zoneSettings.Result))
+	settings := make([]interface{}, 0, len(zoneSettings.Result))
+	for _, s := range zoneSettings.Result {
+		value, err := flattenZoneSettingValue(s.Value)
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("error flattening value of zone setting %q: %w", s.ID, err))
+		}
+
+		settings = append(settings, map[string]interface{}{
+			"id":          s.ID,
+			"value":       value,
+			"editable":    s.Editable,
+			"modified_on": s.ModifiedOn,
+		})
+		settingIDs = append(settingIDs, s.ID)
+	}
+
+	if err := d.Set("settings", settings); err != nil {
+		return diag.FromErr(fmt.Errorf("error setting zone settings: %w", err))
+	}
+
+	d.SetId(stringListChecksum(append([]string{zoneID}, settingIDs...)))
+
+	return nil
+}
+
+// flattenZoneSettingValue converts the loosely typed value of a zone setting
+// into its string representation.
+func flattenZoneSettingValue(value interface{}) (string, error) {
+	switch v := value.(type) {
+	case nil:
+		return "", nil
+	case string:
+		return v, nil
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64), nil
+	case bool:
+		return strconv.FormatBool(v), nil
+	default:
+		b, err := json.Marshal(v)
+		if err != nil {
+			return "", err
+		}
+		return string(b), nil
+	}
+}
@@ -0,0 +1,64 @@
+package sdkv2provider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccCloudflareZoneSettings(t *testing.T) {
+	zoneID := os.Getenv("CLOUDFLARE_ZONE_ID")
+	rnd := generateRandomResourceName()
+	name := fmt.Sprintf("data.cloudflare_zone_settings.%s", rnd)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareZoneSettingsConfig(zoneID, rnd),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(name, "id"),
+					resource.TestCheckResourceAttr(name, "zone_id", zoneID),
+					resource.TestCheckResourceAttrSet(name, "settings.#"),
+					resource.TestCheckTypeSetElemNestedAttrs(name, "settings.*", map[string]string{
+						"id": "always_use_https",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func TestFlattenZoneSettingValue(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		value    interface{}
+		expected string
+	}{
+		{nil, ""},
+		{"on", "on"},
+		{float64(14400), "14400"},
+		{true, "true"},
+		{[]interface{}{"TLSv1.2", "TLSv1.3"}, `["TLSv1.2","TLSv1.3"]`},
+		{map[string]interface{}{"enabled": true}, `{"enabled":true}`},
+	}
+
+	for _, c := range cases {
+		got, err := flattenZoneSettingValue(c.value)
+		assert.NoError(t, err)
+		assert.Equal(t, c.expected, got)
+	}
+}
+
+func testAccCloudflareZoneSettingsConfig(zoneID, name string) string {
+	return fmt.Sprintf(`
+data "cloudflare_zone_settings" "%[1]s" {
+  zone_id = "%[2]s"
+}
+`, name, zoneID)
+}
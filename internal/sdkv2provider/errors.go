@@ -0,0 +1,76 @@
+package sdkv2provider
+
+import (
+	"errors"
+
+	cloudflare "github.com/curtislarson/cloudflare-go"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+)
+
+// cloudflareErrorCategory groups API errors by what the user can do about
+// them.
+type cloudflareErrorCategory string
+
+const (
+	cloudflareErrorCategoryUser      cloudflareErrorCategory = "user"
+	cloudflareErrorCategoryAuth      cloudflareErrorCategory = "auth"
+	cloudflareErrorCategoryNotFound  cloudflareErrorCategory = "not_found"
+	cloudflareErrorCategoryRateLimit cloudflareErrorCategory = "rate_limit"
+	cloudflareErrorCategoryTransient cloudflareErrorCategory = "transient"
+	cloudflareErrorCategoryUnknown   cloudflareErrorCategory = "unknown"
+)
+
+// classifyCloudflareError maps an error returned by the API client to a
+// cloudflareErrorCategory based on the HTTP status it represents.
+func classifyCloudflareError(err error) cloudflareErrorCategory {
+	var (
+		requestError        *cloudflare.RequestError
+		authenticationError *cloudflare.AuthenticationError
+		authorizationError  *cloudflare.AuthorizationError
+		notFoundError       *cloudflare.NotFoundError
+		ratelimitError      *cloudflare.RatelimitError
+		serviceError        *cloudflare.ServiceError
+	)
+
+	switch {
+	case errors.As(err, &requestError):
+		return cloudflareErrorCategoryUser
+	case errors.As(err, &authenticationError), errors.As(err, &authorizationError):
+		return cloudflareErrorCategoryAuth
+	case errors.As(err, &notFoundError):
+		return cloudflareErrorCategoryNotFound
+	case errors.As(err, &ratelimitError):
+		return cloudflareErrorCategoryRateLimit
+	case errors.As(err, &serviceError):
+		return cloudflareErrorCategoryTransient
+	default:
+		return cloudflareErrorCategoryUnknown
+	}
+}
+
+// cloudflareErrorDiagnostic builds an error diagnostic for a failed API call
+// with a detail message suggesting how to resolve it.
+func cloudflareErrorDiagnostic(summary string, err error) diag.Diagnostic {
+	var detail string
+
+	switch classifyCloudflareError(err) {
+	case cloudflareErrorCategoryUser:
+		detail = "The API rejected the request as invalid. Check the resource configuration against the API documentation and correct the values mentioned in the error."
+	case cloudflareErrorCategoryAuth:
+		detail = "The API credentials were rejected or lack the permissions required for this request. Check the credentials configured for the provider and the permissions granted to the API token."
+	case cloudflareErrorCategoryNotFound:
+		detail = "The requested object could not be found. Check the identifiers used in the configuration and that the object exists in the account or zone."
+	case cloudflareErrorCategoryRateLimit:
+		detail = "The API rate limit was exceeded. Retry later or lower the `rps` and `max_concurrent_requests` provider settings."
+	case cloudflareErrorCategoryTransient:
+		detail = "The API returned a server error which is usually temporary. Retry the operation and, if it keeps failing, check https://www.cloudflarestatus.com."
+	default:
+		detail = "An unexpected error occurred while calling the API."
+	}
+
+	return diag.Diagnostic{
+		Severity: diag.Error,
+		Summary:  summary,
+		Detail:   detail + "\n\n" + err.Error(),
+	}
+}
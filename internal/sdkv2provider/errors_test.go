@@ -0,0 +1,34 @@
+package sdkv2provider
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	cloudflare "github.com/curtislarson/cloudflare-go"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClassifyCloudflareError(t *testing.T) {
+	t.Parallel()
+
+	cases := map[string]struct {
+		err      error
+		expected cloudflareErrorCategory
+	}{
+		"request error":        {&cloudflare.RequestError{}, cloudflareErrorCategoryUser},
+		"authentication error": {&cloudflare.AuthenticationError{}, cloudflareErrorCategoryAuth},
+		"authorization error":  {&cloudflare.AuthorizationError{}, cloudflareErrorCategoryAuth},
+		"not found error":      {&cloudflare.NotFoundError{}, cloudflareErrorCategoryNotFound},
+		"ratelimit error":      {&cloudflare.RatelimitError{}, cloudflareErrorCategoryRateLimit},
+		"service error":        {&cloudflare.ServiceError{}, cloudflareErrorCategoryTransient},
+		"wrapped error":        {fmt.Errorf("reading zone: %w", &cloudflare.NotFoundError{}), cloudflareErrorCategoryNotFound},
+		"unknown error":        {errors.New("connection reset by peer"), cloudflareErrorCategoryUnknown},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, c.expected, classifyCloudflareError(c.err))
+		})
+	}
+}
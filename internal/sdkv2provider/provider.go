@@ -134,6 +134,13 @@ func New(version string) func() *schema.Provider {
 					Description: fmt.Sprintf("Maximum backoff period in seconds after failed API calls. Alternatively, can be configured using the `%s` environment variable.", consts.MaximumBackoffEnvVarKey),
 				},
 
+				consts.MaxConcurrentRequestsSchemaKey: {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					ValidateFunc: validation.IntAtLeast(0),
+					Description:  fmt.Sprintf("Maximum number of API requests that may be in flight at the same time. Defaults to `10`; a value of `0` disables the limit. Alternatively, can be configured using the `%s` environment variable.", consts.MaxConcurrentRequestsEnvVarKey),
+				},
+
 				consts.APIClientLoggingSchemaKey: {
 					Type:        schema.TypeBool,
 					Optional:    true,
@@ -165,6 +172,7 @@ func New(version string) func() *schema.Provider {
 				"cloudflare_account_roles":               dataSourceCloudflareAccountRoles(),
 				"cloudflare_accounts":                    dataSourceCloudflareAccounts(),
 				"cloudflare_api_token_permission_groups": dataSourceCloudflareApiTokenPermissionGroups(),
+				"cloudflare_available_plans":             dataSourceCloudflareAvailablePlans(),
 				"cloudflare_devices":                     dataSourceCloudflareDevices(),
 				"cloudflare_ip_ranges":                   dataSourceCloudflareIPRanges(),
 				"cloudflare_load_balancer_pools":         dataSourceCloudflareLoadBalancerPools(),
@@ -173,7 +181,9 @@ func New(version string) func() *schema.Provider {
 				"cloudflare_waf_groups":                  dataSourceCloudflareWAFGroups(),
 				"cloudflare_waf_packages":                dataSourceCloudflareWAFPackages(),
 				"cloudflare_waf_rules":                   dataSourceCloudflareWAFRules(),
+				"cloudflare_workers_kv_keys":             dataSourceCloudflareWorkersKVKeys(),
 				"cloudflare_zone_dnssec":                 dataSourceCloudflareZoneDNSSEC(),
+				"cloudflare_zone_settings":               dataSourceCloudflareZoneSettings(),
 				"cloudflare_zone":                        dataSourceCloudflareZone(),
 				"cloudflare_zones":                       dataSourceCloudflareZones(),
 			},
@@ -190,16 +200,23 @@ func New(version string) func() *schema.Provider {
 				"cloudflare_access_policy":                          resourceCloudflareAccessPolicy(),
 				"cloudflare_access_rule":                            resourceCloudflareAccessRule(),
 				"cloudflare_access_service_token":                   resourceCloudflareAccessServiceToken(),
+				"cloudflare_account_api_token":                      resourceCloudflareAccountAPIToken(),
 				"cloudflare_account_member":                         resourceCloudflareAccountMember(),
 				"cloudflare_account":                                resourceCloudflareAccount(),
+				"cloudflare_ai_gateway":                             resourceCloudflareAIGateway(),
 				"cloudflare_api_shield":                             resourceCloudflareAPIShield(),
+				"cloudflare_api_shield_operation":                   resourceCloudflareAPIShieldOperation(),
+				"cloudflare_api_shield_schema":                      resourceCloudflareAPIShieldSchemas(),
 				"cloudflare_api_token":                              resourceCloudflareApiToken(),
 				"cloudflare_argo_tunnel":                            resourceCloudflareArgoTunnel(),
 				"cloudflare_argo":                                   resourceCloudflareArgo(),
 				"cloudflare_authenticated_origin_pulls_certificate": resourceCloudflareAuthenticatedOriginPullsCertificate(),
 				"cloudflare_authenticated_origin_pulls":             resourceCloudflareAuthenticatedOriginPulls(),
+				"cloudflare_bot_management":                         resourceCloudflareBotManagement(),
 				"cloudflare_byo_ip_prefix":                          resourceCloudflareBYOIPPrefix(),
 				"cloudflare_certificate_pack":                       resourceCloudflareCertificatePack(),
+				"cloudflare_content_scanning":                       resourceCloudflareContentScanning(),
+				"cloudflare_content_scanning_expression":            resourceCloudflareContentScanningExpression(),
 				"cloudflare_custom_hostname_fallback_origin":        resourceCloudflareCustomHostnameFallbackOrigin(),
 				"cloudflare_custom_hostname":                        resourceCloudflareCustomHostname(),
 				"cloudflare_custom_pages":                           resourceCloudflareCustomPages(),
@@ -210,6 +227,7 @@ func New(version string) func() *schema.Provider {
 				"cloudflare_device_posture_rule":                    resourceCloudflareDevicePostureRule(),
 				"cloudflare_device_managed_networks":                resourceCloudflareDeviceManagedNetworks(),
 				"cloudflare_dlp_profile":                            resourceCloudflareDLPProfile(),
+				"cloudflare_dns_records_batch":                      resourceCloudflareDNSRecordsBatch(),
 				"cloudflare_email_routing_address":                  resourceCloudflareEmailRoutingAddress(),
 				"cloudflare_email_routing_catch_all":                resourceCloudflareEmailRoutingCatchAll(),
 				"cloudflare_email_routing_rule":                     resourceCloudflareEmailRoutingRule(),
@@ -221,6 +239,8 @@ func New(version string) func() *schema.Provider {
 				"cloudflare_healthcheck":                            resourceCloudflareHealthcheck(),
 				"cloudflare_ip_list":                                resourceCloudflareIPList(),
 				"cloudflare_ipsec_tunnel":                           resourceCloudflareIPsecTunnel(),
+				"cloudflare_leaked_credential_check":                resourceCloudflareLeakedCredentialCheck(),
+				"cloudflare_leaked_credential_check_rule":           resourceCloudflareLeakedCredentialCheckRule(),
 				"cloudflare_list":                                   resourceCloudflareList(),
 				"cloudflare_load_balancer_monitor":                  resourceCloudflareLoadBalancerMonitor(),
 				"cloudflare_load_balancer_pool":                     resourceCloudflareLoadBalancerPool(),
@@ -230,15 +250,25 @@ func New(version string) func() *schema.Provider {
 				"cloudflare_logpush_ownership_challenge":            resourceCloudflareLogpushOwnershipChallenge(),
 				"cloudflare_magic_firewall_ruleset":                 resourceCloudflareMagicFirewallRuleset(),
 				"cloudflare_managed_headers":                        resourceCloudflareManagedHeaders(),
+				"cloudflare_mtls_certificate":                       resourceCloudflareMTLSCertificate(),
 				"cloudflare_notification_policy_webhooks":           resourceCloudflareNotificationPolicyWebhook(),
 				"cloudflare_notification_policy":                    resourceCloudflareNotificationPolicy(),
 				"cloudflare_origin_ca_certificate":                  resourceCloudflareOriginCACertificate(),
+				"cloudflare_origin_cache_control":                   resourceCloudflareOriginCacheControl(),
+				"cloudflare_origin_error_page_pass_thru":            resourceCloudflareOriginErrorPagePassThru(),
 				"cloudflare_page_rule":                              resourceCloudflarePageRule(),
 				"cloudflare_pages_domain":                           resourceCloudflarePagesDomain(),
 				"cloudflare_pages_project":                          resourceCloudflarePagesProject(),
+				"cloudflare_r2_bucket_event_notification":           resourceCloudflareR2BucketEventNotification(),
+				"cloudflare_r2_bucket_lock":                         resourceCloudflareR2BucketLock(),
+				"cloudflare_r2_custom_domain":                       resourceCloudflareR2CustomDomain(),
 				"cloudflare_rate_limit":                             resourceCloudflareRateLimit(),
 				"cloudflare_record":                                 resourceCloudflareRecord(),
+				"cloudflare_regional_tiered_cache":                  resourceCloudflareRegionalTieredCache(),
 				"cloudflare_ruleset":                                resourceCloudflareRuleset(),
+				"cloudflare_schema_validation_settings":             resourceCloudflareSchemaValidationSettings(),
+				"cloudflare_snippet":                                resourceCloudflareSnippet(),
+				"cloudflare_sort_query_string_for_cache":            resourceCloudflareSortQueryStringForCache(),
 				"cloudflare_spectrum_application":                   resourceCloudflareSpectrumApplication(),
 				"cloudflare_split_tunnel":                           resourceCloudflareSplitTunnel(),
 				"cloudflare_static_route":                           resourceCloudflareStaticRoute(),
@@ -254,6 +284,7 @@ func New(version string) func() *schema.Provider {
 				"cloudflare_tunnel_virtual_network":                 resourceCloudflareTunnelVirtualNetwork(),
 				"cloudflare_url_normalization_settings":             resourceCloudflareURLNormalizationSettings(),
 				"cloudflare_user_agent_blocking_rule":               resourceCloudflareUserAgentBlockingRules(),
+				"cloudflare_vectorize_index":                        resourceCloudflareVectorizeIndex(),
 				"cloudflare_waf_group":                              resourceCloudflareWAFGroup(),
 				"cloudflare_waf_override":                           resourceCloudflareWAFOverride(),
 				"cloudflare_waf_package":                            resourceCloudflareWAFPackage(),
@@ -294,6 +325,7 @@ func configure(version string, p *schema.Provider) func(context.Context, *schema
 			retries           int64
 			minBackOff        int64
 			maxBackOff        int64
+			maxConcurrent     int64
 			accountID         string
 			baseHostname      string
 			basePath          string
@@ -341,6 +373,16 @@ func configure(version string, p *schema.Provider) func(context.Context, *schema
 			maxBackOff = i
 		}
 
+		// GetOk treats an explicit 0 as unset, which would let the environment
+		// variable override a configuration disabling the limit.
+		//nolint:staticcheck
+		if _, ok := d.GetOkExists(consts.MaxConcurrentRequestsSchemaKey); ok {
+			maxConcurrent = int64(d.Get(consts.MaxConcurrentRequestsSchemaKey).(int))
+		} else {
+			i, _ := strconv.ParseInt(utils.GetDefaultFromEnv(consts.MaxConcurrentRequestsEnvVarKey, consts.MaxConcurrentRequestsDefault), 10, 64)
+			maxConcurrent = i
+		}
+
 		if retries > strconv.IntSize {
 			diags = append(diags, diag.Diagnostic{
 				Severity: diag.Error,
@@ -376,7 +418,7 @@ func configure(version string, p *schema.Provider) func(context.Context, *schema
 		ua := fmt.Sprintf(consts.UserAgentDefault, p.TerraformVersion, meta.SDKVersionString(), version)
 		options = append(options, cloudflare.UserAgent(ua))
 
-		config := Config{Options: options}
+		config := Config{Options: options, MaxConcurrentRequests: int(maxConcurrent)}
 
 		if v, ok := d.GetOk(consts.APITokenSchemaKey); ok {
 			apiToken = v.(string)
@@ -171,6 +171,14 @@ func testAccPreCheckBYOIPPrefix(t *testing.T) {
 	}
 }
 
+func testAccPreCheckR2Bucket(t *testing.T) {
+	testAccPreCheckAccount(t)
+
+	if v := os.Getenv("CLOUDFLARE_R2_BUCKET_NAME"); v == "" {
+		t.Skip("Skipping acceptance test as CLOUDFLARE_R2_BUCKET_NAME is not set")
+	}
+}
+
 func generateRandomResourceName() string {
 	return acctest.RandStringFromCharSet(10, acctest.CharSetAlpha)
 }
@@ -0,0 +1,224 @@
+package sdkv2provider
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/cloudflare/terraform-provider-cloudflare/internal/consts"
+	cloudflare "github.com/curtislarson/cloudflare-go"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// accountAPITokenScopes are the permission group scopes which may be granted
+// to an account owned API token.
+var accountAPITokenScopes = []string{"com.cloudflare.api.account", "com.cloudflare.api.account.zone"}
+
+func resourceCloudflareAccountAPIToken() *schema.Resource {
+	return &schema.Resource{
+		Schema:        resourceCloudflareAccountAPITokenSchema(),
+		CreateContext: resourceCloudflareAccountAPITokenCreate,
+		ReadContext:   resourceCloudflareAccountAPITokenRead,
+		UpdateContext: resourceCloudflareAccountAPITokenUpdate,
+		DeleteContext: resourceCloudflareAccountAPITokenDelete,
+		CustomizeDiff: resourceCloudflareAccountAPITokenCustomizeDiff,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceCloudflareAccountAPITokenImport,
+		},
+		Description: heredoc.Doc(`
+			Provides a resource which manages API tokens owned by an account
+			rather than a user. Account owned tokens keep working when the
+			user who created them leaves the account.
+
+			The token value is only returned when the token is created and
+			will not be available after importing.
+		`),
+	}
+}
+
+func resourceCloudflareAccountAPITokenCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	accountID := d.Get(consts.AccountIDSchemaKey).(string)
+	name := d.Get("name").(string)
+
+	tflog.Info(ctx, fmt.Sprintf("Creating Cloudflare account API Token %q in account %q", name, accountID))
+
+	res, err := client.Raw(ctx, http.MethodPost, fmt.Sprintf("/accounts/%s/tokens", accountID), buildAccountAPIToken(d), nil)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error creating Cloudflare account API Token %q: %w", name, err))
+	}
+
+	var token cloudflare.APIToken
+	if err := json.Unmarshal(res, &token); err != nil {
+		return diag.FromErr(fmt.Errorf("failed to unmarshal account API Token response: %w", err))
+	}
+
+	d.SetId(token.ID)
+	d.Set("value", token.Value)
+
+	return resourceCloudflareAccountAPITokenRead(ctx, d, meta)
+}
+
+func resourceCloudflareAccountAPITokenRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	accountID := d.Get(consts.AccountIDSchemaKey).(string)
+
+	res, err := client.Raw(ctx, http.MethodGet, fmt.Sprintf("/accounts/%s/tokens/%s", accountID, d.Id()), nil, nil)
+	if err != nil {
+		var notFoundError *cloudflare.NotFoundError
+		if errors.As(err, &notFoundError) {
+			tflog.Info(ctx, fmt.Sprintf("Cloudflare account API Token %s no longer exists", d.Id()))
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("error finding Cloudflare account API Token %q: %w", d.Id(), err))
+	}
+
+	var token cloudflare.APIToken
+	if err := json.Unmarshal(res, &token); err != nil {
+		return diag.FromErr(fmt.Errorf("failed to unmarshal account API Token response: %w", err))
+	}
+
+	d.Set("name", token.Name)
+	d.Set("policies", flattenAPITokenPolicies(token.Policies))
+	d.Set("status", token.Status)
+
+	if token.IssuedOn != nil {
+		d.Set("issued_on", token.IssuedOn.Format(time.RFC3339Nano))
+	}
+
+	if token.ModifiedOn != nil {
+		d.Set("modified_on", token.ModifiedOn.Format(time.RFC3339Nano))
+	}
+
+	return nil
+}
+
+func resourceCloudflareAccountAPITokenUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	accountID := d.Get(consts.AccountIDSchemaKey).(string)
+	name := d.Get("name").(string)
+
+	tflog.Info(ctx, fmt.Sprintf("Updating Cloudflare account API Token %q in account %q", name, accountID))
+
+	_, err := client.Raw(ctx, http.MethodPut, fmt.Sprintf("/accounts/%s/tokens/%s", accountID, d.Id()), buildAccountAPIToken(d), nil)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error updating Cloudflare account API Token %q: %w", name, err))
+	}
+
+	return resourceCloudflareAccountAPITokenRead(ctx, d, meta)
+}
+
+func resourceCloudflareAccountAPITokenDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	accountID := d.Get(consts.AccountIDSchemaKey).(string)
+
+	tflog.Info(ctx, fmt.Sprintf("Deleting Cloudflare account API Token %q in account %q", d.Id(), accountID))
+
+	_, err := client.Raw(ctx, http.MethodDelete, fmt.Sprintf("/accounts/%s/tokens/%s", accountID, d.Id()), nil, nil)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error deleting Cloudflare account API Token %q: %w", d.Id(), err))
+	}
+
+	return nil
+}
+
+func resourceCloudflareAccountAPITokenImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	idAttr := strings.SplitN(d.Id(), "/", 2)
+
+	if len(idAttr) != 2 {
+		return nil, fmt.Errorf("invalid id (\"%s\") specified, should be in format \"accountID/tokenID\"", d.Id())
+	}
+
+	accountID, tokenID := idAttr[0], idAttr[1]
+
+	tflog.Debug(ctx, fmt.Sprintf("Importing Cloudflare account API Token: id %s for account %s", tokenID, accountID))
+
+	d.Set(consts.AccountIDSchemaKey, accountID)
+	d.SetId(tokenID)
+
+	resourceCloudflareAccountAPITokenRead(ctx, d, meta)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// resourceCloudflareAccountAPITokenCustomizeDiff rejects policies which
+// reference permission groups that can't be granted to an account owned
+// token, such as user scoped ones, before the token is created.
+func resourceCloudflareAccountAPITokenCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.HasChange("policies") || !d.NewValueKnown("policies") {
+		return nil
+	}
+
+	var ids []string
+	for _, p := range d.Get("policies").(*schema.Set).List() {
+		policy := p.(map[string]interface{})
+		ids = append(ids, expandInterfaceToStringList(policy["permission_groups"].(*schema.Set).List())...)
+	}
+
+	if len(ids) == 0 {
+		return nil
+	}
+
+	client := meta.(*cloudflare.API)
+	groups, err := client.ListAPITokensPermissionGroups(ctx)
+	if err != nil {
+		tflog.Warn(ctx, fmt.Sprintf("unable to list API Token Permission Groups to validate policies: %s", err))
+		return nil
+	}
+
+	if invalid := nonAccountScopedPermissionGroups(groups, ids); len(invalid) > 0 {
+		return fmt.Errorf("policies reference permission groups which are not account scoped: %s", strings.Join(invalid, ", "))
+	}
+
+	return nil
+}
+
+// nonAccountScopedPermissionGroups returns the IDs of the permission groups
+// which are known but don't have an account or zone scope. Unknown IDs are
+// left for the API to reject.
+func nonAccountScopedPermissionGroups(groups []cloudflare.APITokenPermissionGroups, ids []string) []string {
+	scopes := make(map[string][]string, len(groups))
+	for _, g := range groups {
+		scopes[g.ID] = g.Scopes
+	}
+
+	invalid := []string{}
+	for _, id := range ids {
+		groupScopes, ok := scopes[id]
+		if !ok {
+			continue
+		}
+
+		accountScoped := false
+		for _, scope := range accountAPITokenScopes {
+			if contains(groupScopes, scope) {
+				accountScoped = true
+			}
+		}
+
+		if !accountScoped {
+			invalid = append(invalid, id)
+		}
+	}
+
+	sort.Strings(invalid)
+
+	return invalid
+}
+
+func buildAccountAPIToken(d *schema.ResourceData) cloudflare.APIToken {
+	return cloudflare.APIToken{
+		Name:     d.Get("name").(string),
+		Status:   d.Get("status").(string),
+		Policies: expandAPITokenPolicies(d.Get("policies").(*schema.Set).List()),
+	}
+}
@@ -0,0 +1,120 @@
+package sdkv2provider
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	cloudflare "github.com/curtislarson/cloudflare-go"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccCloudflareAccountAPIToken_Basic(t *testing.T) {
+	rnd := generateRandomResourceName()
+	name := "cloudflare_account_api_token." + rnd
+	accountID := os.Getenv("CLOUDFLARE_ACCOUNT_ID")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAccount(t)
+		},
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareAccountAPITokenConfig(rnd, accountID, rnd, "active"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "account_id", accountID),
+					resource.TestCheckResourceAttr(name, "name", rnd),
+					resource.TestCheckResourceAttr(name, "status", "active"),
+					resource.TestCheckResourceAttr(name, "policies.#", "1"),
+					resource.TestCheckResourceAttrSet(name, "value"),
+					resource.TestCheckResourceAttrSet(name, "issued_on"),
+				),
+			},
+			{
+				Config: testAccCloudflareAccountAPITokenConfig(rnd, accountID, rnd+"-updated", "disabled"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "name", rnd+"-updated"),
+					resource.TestCheckResourceAttr(name, "status", "disabled"),
+					resource.TestCheckResourceAttrSet(name, "value"),
+				),
+			},
+			{
+				ResourceName:            name,
+				ImportState:             true,
+				ImportStateIdPrefix:     fmt.Sprintf("%s/", accountID),
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"value"},
+			},
+		},
+	})
+}
+
+func TestAccCloudflareAccountAPIToken_UserScopedPermissionGroup(t *testing.T) {
+	rnd := generateRandomResourceName()
+	accountID := os.Getenv("CLOUDFLARE_ACCOUNT_ID")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAccount(t)
+		},
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCloudflareAccountAPITokenUserScopedConfig(rnd, accountID),
+				ExpectError: regexp.MustCompile(`policies reference permission groups which are not account scoped`),
+			},
+		},
+	})
+}
+
+func TestNonAccountScopedPermissionGroups(t *testing.T) {
+	groups := []cloudflare.APITokenPermissionGroups{
+		{ID: "account", Scopes: []string{"com.cloudflare.api.account"}},
+		{ID: "zone", Scopes: []string{"com.cloudflare.api.account.zone"}},
+		{ID: "user", Scopes: []string{"com.cloudflare.api.user"}},
+		{ID: "r2", Scopes: []string{"com.cloudflare.edge.r2.bucket"}},
+	}
+
+	assert.Equal(t, []string{}, nonAccountScopedPermissionGroups(groups, []string{"account", "zone", "unknown"}))
+	assert.Equal(t, []string{"r2", "user"}, nonAccountScopedPermissionGroups(groups, []string{"zone", "user", "r2"}))
+}
+
+func testAccCloudflareAccountAPITokenConfig(rnd, accountID, name, status string) string {
+	return fmt.Sprintf(`
+data "cloudflare_api_token_permission_groups" "all" {}
+
+resource "cloudflare_account_api_token" "%[1]s" {
+	account_id = "%[2]s"
+	name       = "%[3]s"
+	status     = "%[4]s"
+
+	policies {
+		permission_groups = [data.cloudflare_api_token_permission_groups.all.account["Workers Scripts Read"]]
+		resources = {
+			"com.cloudflare.api.account.%[2]s" = "*"
+		}
+	}
+}`, rnd, accountID, name, status)
+}
+
+func testAccCloudflareAccountAPITokenUserScopedConfig(rnd, accountID string) string {
+	return fmt.Sprintf(`
+data "cloudflare_api_token_permission_groups" "all" {}
+
+resource "cloudflare_account_api_token" "%[1]s" {
+	account_id = "%[2]s"
+	name       = "%[1]s"
+
+	policies {
+		permission_groups = [data.cloudflare_api_token_permission_groups.all.user["User Details Read"]]
+		resources = {
+			"com.cloudflare.api.account.%[2]s" = "*"
+		}
+	}
+}`, rnd, accountID)
+}
@@ -0,0 +1,154 @@
+package sdkv2provider
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/cloudflare/terraform-provider-cloudflare/internal/consts"
+	cloudflare "github.com/curtislarson/cloudflare-go"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// aiGateway is an AI Gateway of an account.
+type aiGateway struct {
+	ID                      string `json:"id"`
+	CacheTTL                int    `json:"cache_ttl"`
+	CacheInvalidateOnUpdate bool   `json:"cache_invalidate_on_update"`
+	CollectLogs             bool   `json:"collect_logs"`
+	RateLimitingInterval    int    `json:"rate_limiting_interval"`
+	RateLimitingLimit       int    `json:"rate_limiting_limit"`
+	RateLimitingTechnique   string `json:"rate_limiting_technique"`
+}
+
+func resourceCloudflareAIGateway() *schema.Resource {
+	return &schema.Resource{
+		Schema:        resourceCloudflareAIGatewaySchema(),
+		CreateContext: resourceCloudflareAIGatewayCreate,
+		ReadContext:   resourceCloudflareAIGatewayRead,
+		UpdateContext: resourceCloudflareAIGatewayUpdate,
+		DeleteContext: resourceCloudflareAIGatewayDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceCloudflareAIGatewayImport,
+		},
+		Description: heredoc.Doc(`
+			Provides a resource to manage an AI Gateway, which proxies
+			requests to AI model providers with caching, logging and rate
+			limiting.
+		`),
+	}
+}
+
+func resourceCloudflareAIGatewayCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	accountID := d.Get(consts.AccountIDSchemaKey).(string)
+	gateway := buildAIGateway(d)
+
+	tflog.Debug(ctx, fmt.Sprintf("Creating Cloudflare AI Gateway %q in account %q", gateway.ID, accountID))
+
+	_, err := client.Raw(ctx, http.MethodPost, fmt.Sprintf("/accounts/%s/ai-gateway/gateways", accountID), gateway, nil)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error creating AI Gateway %q: %w", gateway.ID, err))
+	}
+
+	d.SetId(gateway.ID)
+
+	return resourceCloudflareAIGatewayRead(ctx, d, meta)
+}
+
+func resourceCloudflareAIGatewayRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	accountID := d.Get(consts.AccountIDSchemaKey).(string)
+
+	res, err := client.Raw(ctx, http.MethodGet, fmt.Sprintf("/accounts/%s/ai-gateway/gateways/%s", accountID, d.Id()), nil, nil)
+	if err != nil {
+		var notFoundError *cloudflare.NotFoundError
+		if errors.As(err, &notFoundError) {
+			tflog.Info(ctx, fmt.Sprintf("AI Gateway %q not found", d.Id()))
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("error reading AI Gateway %q: %w", d.Id(), err))
+	}
+
+	var gateway aiGateway
+	if err := json.Unmarshal(res, &gateway); err != nil {
+		return diag.FromErr(fmt.Errorf("failed to unmarshal AI Gateway response: %w", err))
+	}
+
+	d.Set("name", gateway.ID)
+	d.Set("cache_ttl", gateway.CacheTTL)
+	d.Set("cache_invalidate_on_update", gateway.CacheInvalidateOnUpdate)
+	d.Set("collect_logs", gateway.CollectLogs)
+	d.Set("rate_limiting_interval", gateway.RateLimitingInterval)
+	d.Set("rate_limiting_limit", gateway.RateLimitingLimit)
+	d.Set("rate_limiting_technique", gateway.RateLimitingTechnique)
+
+	return nil
+}
+
+func resourceCloudflareAIGatewayUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	accountID := d.Get(consts.AccountIDSchemaKey).(string)
+	gateway := buildAIGateway(d)
+
+	tflog.Debug(ctx, fmt.Sprintf("Updating Cloudflare AI Gateway %q in account %q", d.Id(), accountID))
+
+	_, err := client.Raw(ctx, http.MethodPut, fmt.Sprintf("/accounts/%s/ai-gateway/gateways/%s", accountID, d.Id()), gateway, nil)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error updating AI Gateway %q: %w", d.Id(), err))
+	}
+
+	return resourceCloudflareAIGatewayRead(ctx, d, meta)
+}
+
+func resourceCloudflareAIGatewayDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	accountID := d.Get(consts.AccountIDSchemaKey).(string)
+
+	tflog.Debug(ctx, fmt.Sprintf("Deleting Cloudflare AI Gateway %q in account %q", d.Id(), accountID))
+
+	_, err := client.Raw(ctx, http.MethodDelete, fmt.Sprintf("/accounts/%s/ai-gateway/gateways/%s", accountID, d.Id()), nil, nil)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error deleting AI Gateway %q: %w", d.Id(), err))
+	}
+
+	return nil
+}
+
+func resourceCloudflareAIGatewayImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	idAttr := strings.SplitN(d.Id(), "/", 2)
+
+	if len(idAttr) != 2 {
+		return nil, fmt.Errorf("invalid id (\"%s\") specified, should be in format \"accountID/gatewayID\"", d.Id())
+	}
+
+	accountID, gatewayID := idAttr[0], idAttr[1]
+
+	tflog.Debug(ctx, fmt.Sprintf("Importing Cloudflare AI Gateway: id %s for account %s", gatewayID, accountID))
+
+	d.Set(consts.AccountIDSchemaKey, accountID)
+	d.SetId(gatewayID)
+
+	resourceCloudflareAIGatewayRead(ctx, d, meta)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func buildAIGateway(d *schema.ResourceData) aiGateway {
+	return aiGateway{
+		ID:                      d.Get("name").(string),
+		CacheTTL:                d.Get("cache_ttl").(int),
+		CacheInvalidateOnUpdate: d.Get("cache_invalidate_on_update").(bool),
+		CollectLogs:             d.Get("collect_logs").(bool),
+		RateLimitingInterval:    d.Get("rate_limiting_interval").(int),
+		RateLimitingLimit:       d.Get("rate_limiting_limit").(int),
+		RateLimitingTechnique:   d.Get("rate_limiting_technique").(string),
+	}
+}
@@ -0,0 +1,83 @@
+package sdkv2provider
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccCloudflareAIGateway_Basic(t *testing.T) {
+	rnd := generateRandomResourceName()
+	name := "cloudflare_ai_gateway." + rnd
+	accountID := os.Getenv("CLOUDFLARE_ACCOUNT_ID")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAccount(t)
+		},
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareAIGatewayConfig(rnd, accountID, 60, "fixed"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "account_id", accountID),
+					resource.TestCheckResourceAttr(name, "name", rnd),
+					resource.TestCheckResourceAttr(name, "cache_ttl", "60"),
+					resource.TestCheckResourceAttr(name, "collect_logs", "true"),
+					resource.TestCheckResourceAttr(name, "rate_limiting_interval", "60"),
+					resource.TestCheckResourceAttr(name, "rate_limiting_limit", "100"),
+					resource.TestCheckResourceAttr(name, "rate_limiting_technique", "fixed"),
+				),
+			},
+			{
+				Config: testAccCloudflareAIGatewayConfig(rnd, accountID, 3600, "sliding"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "cache_ttl", "3600"),
+					resource.TestCheckResourceAttr(name, "rate_limiting_technique", "sliding"),
+				),
+			},
+			{
+				ResourceName:        name,
+				ImportState:         true,
+				ImportStateIdPrefix: fmt.Sprintf("%s/", accountID),
+				ImportStateVerify:   true,
+			},
+		},
+	})
+}
+
+func TestAccCloudflareAIGateway_InvalidRateLimitingTechnique(t *testing.T) {
+	rnd := generateRandomResourceName()
+	accountID := os.Getenv("CLOUDFLARE_ACCOUNT_ID")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAccount(t)
+		},
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCloudflareAIGatewayConfig(rnd, accountID, 60, "leaky_bucket"),
+				ExpectError: regexp.MustCompile(`expected rate_limiting_technique to be one of`),
+			},
+		},
+	})
+}
+
+func testAccCloudflareAIGatewayConfig(rnd, accountID string, cacheTTL int, technique string) string {
+	return fmt.Sprintf(`
+resource "cloudflare_ai_gateway" "%[1]s" {
+	account_id              = "%[2]s"
+	name                    = "%[1]s"
+	cache_ttl               = %[3]d
+	rate_limiting_interval  = 60
+	rate_limiting_limit     = 100
+	rate_limiting_technique = "%[4]s"
+}
+`, rnd, accountID, cacheTTL, technique)
+}
@@ -0,0 +1,158 @@
+package sdkv2provider
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"path"
+	"regexp"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/cloudflare/terraform-provider-cloudflare/internal/consts"
+	cloudflare "github.com/curtislarson/cloudflare-go"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var apiShieldOperationPathParameter = regexp.MustCompile(`\{[^{}/]*\}`)
+
+// apiShieldOperation is an endpoint managed by API Shield.
+type apiShieldOperation struct {
+	ID       string `json:"operation_id,omitempty"`
+	Method   string `json:"method"`
+	Host     string `json:"host"`
+	Endpoint string `json:"endpoint"`
+}
+
+func resourceCloudflareAPIShieldOperation() *schema.Resource {
+	return &schema.Resource{
+		Schema:        resourceCloudflareAPIShieldOperationSchema(),
+		CreateContext: resourceCloudflareAPIShieldOperationCreate,
+		ReadContext:   resourceCloudflareAPIShieldOperationRead,
+		DeleteContext: resourceCloudflareAPIShieldOperationDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceCloudflareAPIShieldOperationImport,
+		},
+		Description: heredoc.Doc(`
+			Provides a resource to manage an operation in API Shield Endpoint
+			Management.
+		`),
+	}
+}
+
+func resourceCloudflareAPIShieldOperationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	zoneID := d.Get(consts.ZoneIDSchemaKey).(string)
+
+	operation := apiShieldOperation{
+		Method:   d.Get("method").(string),
+		Host:     d.Get("host").(string),
+		Endpoint: d.Get("endpoint").(string),
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Creating Cloudflare API Shield operation for zone %q from struct: %+v", zoneID, operation))
+
+	res, err := client.Raw(ctx, http.MethodPost, fmt.Sprintf("/zones/%s/api_gateway/operations", zoneID), []apiShieldOperation{operation}, nil)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error creating API Shield operation for zone %q: %w", zoneID, err))
+	}
+
+	var operations []apiShieldOperation
+	if err := json.Unmarshal(res, &operations); err != nil {
+		return diag.FromErr(fmt.Errorf("failed to unmarshal API Shield operation response: %w", err))
+	}
+
+	if len(operations) != 1 || operations[0].ID == "" {
+		return diag.FromErr(fmt.Errorf("failed to find ID in Create response; resource was empty"))
+	}
+
+	d.SetId(operations[0].ID)
+
+	return resourceCloudflareAPIShieldOperationRead(ctx, d, meta)
+}
+
+func resourceCloudflareAPIShieldOperationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	zoneID := d.Get(consts.ZoneIDSchemaKey).(string)
+
+	res, err := client.Raw(ctx, http.MethodGet, fmt.Sprintf("/zones/%s/api_gateway/operations/%s", zoneID, d.Id()), nil, nil)
+	if err != nil {
+		var notFoundError *cloudflare.NotFoundError
+		if errors.As(err, &notFoundError) {
+			tflog.Info(ctx, fmt.Sprintf("API Shield operation %q not found in zone %q", d.Id(), zoneID))
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("error reading API Shield operation %q for zone %q: %w", d.Id(), zoneID, err))
+	}
+
+	var operation apiShieldOperation
+	if err := json.Unmarshal(res, &operation); err != nil {
+		return diag.FromErr(fmt.Errorf("failed to unmarshal API Shield operation response: %w", err))
+	}
+
+	d.Set("method", operation.Method)
+	d.Set("host", operation.Host)
+	d.Set("endpoint", operation.Endpoint)
+
+	return nil
+}
+
+func resourceCloudflareAPIShieldOperationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	zoneID := d.Get(consts.ZoneIDSchemaKey).(string)
+
+	tflog.Debug(ctx, fmt.Sprintf("Deleting API Shield operation %q for zone %q", d.Id(), zoneID))
+
+	_, err := client.Raw(ctx, http.MethodDelete, fmt.Sprintf("/zones/%s/api_gateway/operations/%s", zoneID, d.Id()), nil, nil)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error deleting API Shield operation %q for zone %q: %w", d.Id(), zoneID, err))
+	}
+
+	return nil
+}
+
+func resourceCloudflareAPIShieldOperationImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	idAttr := strings.SplitN(d.Id(), "/", 2)
+
+	if len(idAttr) != 2 {
+		return nil, fmt.Errorf("invalid id (\"%s\") specified, should be in format \"zoneID/operationID\"", d.Id())
+	}
+
+	zoneID, operationID := idAttr[0], idAttr[1]
+
+	tflog.Debug(ctx, fmt.Sprintf("Importing Cloudflare API Shield operation: id %s for zone %s", operationID, zoneID))
+
+	d.Set(consts.ZoneIDSchemaKey, zoneID)
+	d.SetId(operationID)
+
+	resourceCloudflareAPIShieldOperationRead(ctx, d, meta)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// normalizeAPIShieldOperationEndpoint returns the endpoint in the form stored
+// by the API: path parameter templates are renamed to `{varN}` from left to
+// right and duplicate slashes and dot segments are removed.
+func normalizeAPIShieldOperationEndpoint(endpoint string) string {
+	n := 0
+	endpoint = apiShieldOperationPathParameter.ReplaceAllStringFunc(endpoint, func(string) string {
+		n++
+		return fmt.Sprintf("{var%d}", n)
+	})
+
+	cleaned := path.Clean("/" + endpoint)
+	if strings.HasSuffix(endpoint, "/") && cleaned != "/" {
+		cleaned += "/"
+	}
+
+	return cleaned
+}
+
+func suppressAPIShieldOperationEndpointNormalization(k, old, new string, d *schema.ResourceData) bool {
+	return normalizeAPIShieldOperationEndpoint(old) == normalizeAPIShieldOperationEndpoint(new)
+}
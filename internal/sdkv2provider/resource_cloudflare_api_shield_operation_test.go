@@ -0,0 +1,84 @@
+package sdkv2provider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccCloudflareAPIShieldOperation_Basic(t *testing.T) {
+	rnd := generateRandomResourceName()
+	resourceID := "cloudflare_api_shield_operation." + rnd
+	zoneID := os.Getenv("CLOUDFLARE_ZONE_ID")
+	domain := os.Getenv("CLOUDFLARE_DOMAIN")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareAPIShieldOperation(rnd, zoneID, domain),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceID, "zone_id", zoneID),
+					resource.TestCheckResourceAttr(resourceID, "method", "GET"),
+					resource.TestCheckResourceAttr(resourceID, "host", domain),
+					resource.TestCheckResourceAttr(resourceID, "endpoint", fmt.Sprintf("/%s/{var1}", rnd)),
+				),
+			},
+			{
+				ResourceName:        resourceID,
+				ImportState:         true,
+				ImportStateIdPrefix: fmt.Sprintf("%s/", zoneID),
+				ImportStateVerify:   true,
+			},
+		},
+	})
+}
+
+func testAccCloudflareAPIShieldOperation(rnd, zoneID, domain string) string {
+	return fmt.Sprintf(`
+	resource "cloudflare_api_shield_operation" "%[1]s" {
+		zone_id  = "%[2]s"
+		method   = "GET"
+		host     = "%[3]s"
+		endpoint = "/%[1]s/{id}"
+	}
+`, rnd, zoneID, domain)
+}
+
+func TestNormalizeAPIShieldOperationEndpoint(t *testing.T) {
+	testCases := map[string]struct {
+		endpoint string
+		expected string
+	}{
+		"plain path": {
+			endpoint: "/api/users",
+			expected: "/api/users",
+		},
+		"named parameters": {
+			endpoint: "/api/users/{id}/posts/{post}",
+			expected: "/api/users/{var1}/posts/{var2}",
+		},
+		"already normalized": {
+			endpoint: "/api/users/{var1}",
+			expected: "/api/users/{var1}",
+		},
+		"duplicate slashes and dot segments": {
+			endpoint: "//api/./users/../users/{id}",
+			expected: "/api/users/{var1}",
+		},
+		"trailing slash": {
+			endpoint: "/api/users/",
+			expected: "/api/users/",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, normalizeAPIShieldOperationEndpoint(tc.endpoint))
+		})
+	}
+}
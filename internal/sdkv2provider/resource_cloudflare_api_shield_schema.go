@@ -0,0 +1,189 @@
+package sdkv2provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"mime/multipart"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/cloudflare/terraform-provider-cloudflare/internal/consts"
+	cloudflare "github.com/curtislarson/cloudflare-go"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// apiShieldSchema is a schema uploaded to API Shield.
+type apiShieldSchema struct {
+	ID                string `json:"schema_id,omitempty"`
+	Name              string `json:"name,omitempty"`
+	Kind              string `json:"kind,omitempty"`
+	ValidationEnabled bool   `json:"validation_enabled"`
+	Source            string `json:"source,omitempty"`
+}
+
+func resourceCloudflareAPIShieldSchemas() *schema.Resource {
+	return &schema.Resource{
+		Schema:        resourceCloudflareAPIShieldSchemaSchema(),
+		CreateContext: resourceCloudflareAPIShieldSchemaCreate,
+		ReadContext:   resourceCloudflareAPIShieldSchemaRead,
+		UpdateContext: resourceCloudflareAPIShieldSchemaUpdate,
+		DeleteContext: resourceCloudflareAPIShieldSchemaDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceCloudflareAPIShieldSchemaImport,
+		},
+		Description: heredoc.Doc(`
+			Provides a resource to manage schemas uploaded to API Shield
+			for schema validation.
+		`),
+	}
+}
+
+func resourceCloudflareAPIShieldSchemaCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	zoneID := d.Get(consts.ZoneIDSchemaKey).(string)
+
+	body := &bytes.Buffer{}
+	w := multipart.NewWriter(body)
+
+	fields := map[string]string{
+		"name":               d.Get("name").(string),
+		"kind":               d.Get("kind").(string),
+		"validation_enabled": strconv.FormatBool(d.Get("validation_enabled").(bool)),
+	}
+	for name, value := range fields {
+		if err := w.WriteField(name, value); err != nil {
+			return diag.FromErr(fmt.Errorf("error writing API Shield schema upload: %w", err))
+		}
+	}
+
+	file, err := w.CreateFormFile("file", d.Get("name").(string))
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error writing API Shield schema upload: %w", err))
+	}
+	if _, err := file.Write([]byte(d.Get("source").(string))); err != nil {
+		return diag.FromErr(fmt.Errorf("error writing API Shield schema upload: %w", err))
+	}
+	if err := w.Close(); err != nil {
+		return diag.FromErr(fmt.Errorf("error writing API Shield schema upload: %w", err))
+	}
+
+	headers := make(http.Header)
+	headers.Set("Content-Type", w.FormDataContentType())
+
+	res, err := client.Raw(ctx, http.MethodPost, fmt.Sprintf("/zones/%s/api_gateway/user_schemas", zoneID), body.Bytes(), headers)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error uploading API Shield schema for zone %q: %w", zoneID, err))
+	}
+
+	var result struct {
+		Schema apiShieldSchema `json:"schema"`
+	}
+	if err := json.Unmarshal(res, &result); err != nil {
+		return diag.FromErr(fmt.Errorf("failed to unmarshal API Shield schema response: %w", err))
+	}
+
+	if result.Schema.ID == "" {
+		return diag.FromErr(fmt.Errorf("failed to find ID in Create response; resource was empty"))
+	}
+
+	d.SetId(result.Schema.ID)
+
+	return resourceCloudflareAPIShieldSchemaRead(ctx, d, meta)
+}
+
+func resourceCloudflareAPIShieldSchemaRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	zoneID := d.Get(consts.ZoneIDSchemaKey).(string)
+
+	res, err := client.Raw(ctx, http.MethodGet, fmt.Sprintf("/zones/%s/api_gateway/user_schemas/%s?omit_source=true", zoneID, d.Id()), nil, nil)
+	if err != nil {
+		var notFoundError *cloudflare.NotFoundError
+		if errors.As(err, &notFoundError) {
+			tflog.Info(ctx, fmt.Sprintf("API Shield schema %q not found in zone %q", d.Id(), zoneID))
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("error reading API Shield schema %q for zone %q: %w", d.Id(), zoneID, err))
+	}
+
+	var apiSchema apiShieldSchema
+	if err := json.Unmarshal(res, &apiSchema); err != nil {
+		return diag.FromErr(fmt.Errorf("failed to unmarshal API Shield schema response: %w", err))
+	}
+
+	d.Set("name", apiSchema.Name)
+	d.Set("kind", apiSchema.Kind)
+	d.Set("validation_enabled", apiSchema.ValidationEnabled)
+
+	return nil
+}
+
+func resourceCloudflareAPIShieldSchemaUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	zoneID := d.Get(consts.ZoneIDSchemaKey).(string)
+
+	params := apiShieldSchema{ValidationEnabled: d.Get("validation_enabled").(bool)}
+
+	tflog.Info(ctx, fmt.Sprintf("Updating API Shield schema %q for zone %q from struct: %+v", d.Id(), zoneID, params))
+
+	_, err := client.Raw(ctx, http.MethodPatch, fmt.Sprintf("/zones/%s/api_gateway/user_schemas/%s", zoneID, d.Id()), params, nil)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error updating API Shield schema %q for zone %q: %w", d.Id(), zoneID, err))
+	}
+
+	return resourceCloudflareAPIShieldSchemaRead(ctx, d, meta)
+}
+
+func resourceCloudflareAPIShieldSchemaDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	zoneID := d.Get(consts.ZoneIDSchemaKey).(string)
+
+	tflog.Debug(ctx, fmt.Sprintf("Deleting API Shield schema %q for zone %q", d.Id(), zoneID))
+
+	_, err := client.Raw(ctx, http.MethodDelete, fmt.Sprintf("/zones/%s/api_gateway/user_schemas/%s", zoneID, d.Id()), nil, nil)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error deleting API Shield schema %q for zone %q: %w", d.Id(), zoneID, err))
+	}
+
+	return nil
+}
+
+func resourceCloudflareAPIShieldSchemaImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	idAttr := strings.SplitN(d.Id(), "/", 2)
+
+	if len(idAttr) != 2 {
+		return nil, fmt.Errorf("invalid id (\"%s\") specified, should be in format \"zoneID/schemaID\"", d.Id())
+	}
+
+	zoneID, schemaID := idAttr[0], idAttr[1]
+
+	tflog.Debug(ctx, fmt.Sprintf("Importing Cloudflare API Shield schema: id %s for zone %s", schemaID, zoneID))
+
+	// Reads omit the source to keep them small, so it's only fetched here.
+	// Leaving it unset would force the imported schema to be replaced.
+	client := meta.(*cloudflare.API)
+	res, err := client.Raw(ctx, http.MethodGet, fmt.Sprintf("/zones/%s/api_gateway/user_schemas/%s", zoneID, schemaID), nil, nil)
+	if err != nil {
+		return nil, fmt.Errorf("error reading API Shield schema %q for zone %q: %w", schemaID, zoneID, err)
+	}
+
+	var apiSchema apiShieldSchema
+	if err := json.Unmarshal(res, &apiSchema); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal API Shield schema response: %w", err)
+	}
+
+	d.Set(consts.ZoneIDSchemaKey, zoneID)
+	d.Set("source", apiSchema.Source)
+	d.SetId(schemaID)
+
+	resourceCloudflareAPIShieldSchemaRead(ctx, d, meta)
+
+	return []*schema.ResourceData{d}, nil
+}
@@ -0,0 +1,103 @@
+package sdkv2provider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccCloudflareAPIShieldSchema_Basic(t *testing.T) {
+	rnd := generateRandomResourceName()
+	resourceID := "cloudflare_api_shield_schema." + rnd
+	zoneID := os.Getenv("CLOUDFLARE_ZONE_ID")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareAPIShieldSchema(rnd, zoneID, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceID, "zone_id", zoneID),
+					resource.TestCheckResourceAttr(resourceID, "name", rnd),
+					resource.TestCheckResourceAttr(resourceID, "kind", "openapi_v3"),
+					resource.TestCheckResourceAttr(resourceID, "validation_enabled", "false"),
+				),
+			},
+			{
+				Config: testAccCloudflareAPIShieldSchema(rnd, zoneID, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceID, "validation_enabled", "true"),
+				),
+			},
+			{
+				ResourceName:        resourceID,
+				ImportState:         true,
+				ImportStateIdPrefix: fmt.Sprintf("%s/", zoneID),
+				ImportStateVerify:   true,
+			},
+		},
+	})
+}
+
+func TestValidateAPIShieldSchemaSource(t *testing.T) {
+	testCases := map[string]struct {
+		source string
+		valid  bool
+	}{
+		"json": {
+			source: `{"openapi": "3.0.0", "info": {"title": "example", "version": "1.0"}, "paths": {}}`,
+			valid:  true,
+		},
+		"yaml": {
+			source: "openapi: 3.0.0\ninfo:\n  title: example\n  version: \"1.0\"\npaths: {}\n",
+			valid:  true,
+		},
+		"invalid yaml": {
+			source: "openapi: 3.0.0\n  info: [\n",
+			valid:  false,
+		},
+		"scalar": {
+			source: "openapi",
+			valid:  false,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			_, errs := validateAPIShieldSchemaSource(tc.source, "source")
+			if tc.valid && len(errs) > 0 {
+				t.Errorf("expected source to be valid, got: %v", errs)
+			}
+			if !tc.valid && len(errs) == 0 {
+				t.Errorf("expected source to be invalid")
+			}
+		})
+	}
+}
+
+func testAccCloudflareAPIShieldSchema(rnd, zoneID string, validationEnabled bool) string {
+	return fmt.Sprintf(`
+	resource "cloudflare_api_shield_schema" "%[1]s" {
+		zone_id            = "%[2]s"
+		name               = "%[1]s"
+		validation_enabled = %[3]t
+		source             = <<EOT
+openapi: 3.0.0
+info:
+  title: %[1]s
+  version: "1.0"
+servers:
+  - url: https://api.example.com
+paths:
+  /users:
+    get:
+      responses:
+        "200":
+          description: OK
+EOT
+	}
+`, rnd, zoneID, validationEnabled)
+}
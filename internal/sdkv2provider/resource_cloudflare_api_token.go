@@ -95,7 +95,12 @@ func resourceCloudflareApiTokenCreate(ctx context.Context, d *schema.ResourceDat
 }
 
 func resourceDataToApiTokenPolices(d *schema.ResourceData) []cloudflare.APITokenPolicies {
-	policies := d.Get("policy").(*schema.Set).List()
+	return expandAPITokenPolicies(d.Get("policy").(*schema.Set).List())
+}
+
+// expandAPITokenPolicies converts policy blocks into the policies sent to the
+// API. Policies without any permission groups are skipped.
+func expandAPITokenPolicies(policies []interface{}) []cloudflare.APITokenPolicies {
 	var cfPolicies []cloudflare.APITokenPolicies
 
 	for _, p := range policies {
@@ -151,23 +156,8 @@ func resourceCloudflareApiTokenRead(ctx context.Context, d *schema.ResourceData,
 		return diag.FromErr(fmt.Errorf("error finding Cloudflare API Token %q: %w", d.Id(), err))
 	}
 
-	policies := []map[string]interface{}{}
-
-	for _, p := range t.Policies {
-		permissionGroups := []string{}
-		for _, v := range p.PermissionGroups {
-			permissionGroups = append(permissionGroups, v.ID)
-		}
-
-		policies = append(policies, map[string]interface{}{
-			"resources":         p.Resources,
-			"permission_groups": permissionGroups,
-			"effect":            p.Effect,
-		})
-	}
-
 	d.Set("name", t.Name)
-	d.Set("policy", policies)
+	d.Set("policy", flattenAPITokenPolicies(t.Policies))
 	d.Set("status", t.Status)
 	d.Set("issued_on", t.IssuedOn.Format(time.RFC3339Nano))
 	d.Set("modified_on", t.ModifiedOn.Format(time.RFC3339Nano))
@@ -202,6 +192,27 @@ func resourceCloudflareApiTokenRead(ctx context.Context, d *schema.ResourceData,
 	return nil
 }
 
+// flattenAPITokenPolicies converts the policies returned by the API into
+// policy blocks.
+func flattenAPITokenPolicies(cfPolicies []cloudflare.APITokenPolicies) []map[string]interface{} {
+	policies := []map[string]interface{}{}
+
+	for _, p := range cfPolicies {
+		permissionGroups := []string{}
+		for _, v := range p.PermissionGroups {
+			permissionGroups = append(permissionGroups, v.ID)
+		}
+
+		policies = append(policies, map[string]interface{}{
+			"resources":         p.Resources,
+			"permission_groups": permissionGroups,
+			"effect":            p.Effect,
+		})
+	}
+
+	return policies
+}
+
 func resourceCloudflareApiTokenUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*cloudflare.API)
 
@@ -0,0 +1,152 @@
+package sdkv2provider
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/cloudflare/terraform-provider-cloudflare/internal/consts"
+	cloudflare "github.com/curtislarson/cloudflare-go"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// botManagement is the Bot Management configuration of a zone.
+type botManagement struct {
+	EnableJS                     *bool   `json:"enable_js,omitempty"`
+	FightMode                    *bool   `json:"fight_mode,omitempty"`
+	SBFMDefinitelyAutomated      *string `json:"sbfm_definitely_automated,omitempty"`
+	SBFMLikelyAutomated          *string `json:"sbfm_likely_automated,omitempty"`
+	SBFMVerifiedBots             *string `json:"sbfm_verified_bots,omitempty"`
+	SBFMStaticResourceProtection *bool   `json:"sbfm_static_resource_protection,omitempty"`
+	OptimizeWordpress            *bool   `json:"optimize_wordpress,omitempty"`
+}
+
+func resourceCloudflareBotManagement() *schema.Resource {
+	return &schema.Resource{
+		Schema:        resourceCloudflareBotManagementSchema(),
+		CreateContext: resourceCloudflareBotManagementUpdate,
+		ReadContext:   resourceCloudflareBotManagementRead,
+		UpdateContext: resourceCloudflareBotManagementUpdate,
+		DeleteContext: resourceCloudflareBotManagementDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceCloudflareBotManagementImport,
+		},
+		Description: heredoc.Doc(`
+			Provides a resource to configure Bot Management, including the
+			Super Bot Fight Mode (SBFM) settings available on plans without
+			full Bot Management.
+		`),
+	}
+}
+
+func resourceCloudflareBotManagementRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+
+	tflog.Info(ctx, fmt.Sprintf("Reading Bot Management in zone %q", d.Id()))
+
+	res, err := client.Raw(ctx, http.MethodGet, fmt.Sprintf("/zones/%s/bot_management", d.Id()), nil, nil)
+	if err != nil {
+		var notFoundError *cloudflare.NotFoundError
+		if errors.As(err, &notFoundError) {
+			tflog.Info(ctx, fmt.Sprintf("Bot Management for zone %q not found", d.Id()))
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("error reading bot management for zone %q: %w", d.Id(), err))
+	}
+
+	var config botManagement
+	if err := json.Unmarshal(res, &config); err != nil {
+		return diag.FromErr(fmt.Errorf("failed to unmarshal bot management response: %w", err))
+	}
+
+	d.Set(consts.ZoneIDSchemaKey, d.Id())
+	d.Set("enable_js", cloudflare.Bool(config.EnableJS))
+	d.Set("fight_mode", cloudflare.Bool(config.FightMode))
+	d.Set("sbfm_definitely_automated", cloudflare.String(config.SBFMDefinitelyAutomated))
+	d.Set("sbfm_likely_automated", cloudflare.String(config.SBFMLikelyAutomated))
+	d.Set("sbfm_verified_bots", cloudflare.String(config.SBFMVerifiedBots))
+	d.Set("sbfm_static_resource_protection", cloudflare.Bool(config.SBFMStaticResourceProtection))
+	d.Set("optimize_wordpress", cloudflare.Bool(config.OptimizeWordpress))
+
+	return nil
+}
+
+func resourceCloudflareBotManagementUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+
+	zoneID := d.Get(consts.ZoneIDSchemaKey).(string)
+	d.SetId(zoneID)
+
+	config := buildBotManagement(d)
+
+	tflog.Info(ctx, fmt.Sprintf("Setting Bot Management to struct: %+v for zone ID: %q", config, d.Id()))
+
+	_, err := client.Raw(ctx, http.MethodPut, fmt.Sprintf("/zones/%s/bot_management", d.Id()), config, nil)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error setting bot management for zone %q: %w", d.Id(), err))
+	}
+
+	return resourceCloudflareBotManagementRead(ctx, d, meta)
+}
+
+// resourceCloudflareBotManagementDelete only removes the resource from state
+// as Bot Management can't be removed from a zone.
+func resourceCloudflareBotManagementDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	tflog.Info(ctx, fmt.Sprintf("Removing Bot Management for zone ID %q from state; the configuration is left unchanged", d.Id()))
+
+	return nil
+}
+
+func resourceCloudflareBotManagementImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	d.Set(consts.ZoneIDSchemaKey, d.Id())
+
+	resourceCloudflareBotManagementRead(ctx, d, meta)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// buildBotManagement only includes the attributes set in the configuration so
+// that the remaining settings are left as they are.
+func buildBotManagement(d *schema.ResourceData) botManagement {
+	config := botManagement{}
+
+	//nolint:staticcheck
+	if value, ok := d.GetOkExists("enable_js"); ok {
+		config.EnableJS = cloudflare.BoolPtr(value.(bool))
+	}
+
+	//nolint:staticcheck
+	if value, ok := d.GetOkExists("fight_mode"); ok {
+		config.FightMode = cloudflare.BoolPtr(value.(bool))
+	}
+
+	if value, ok := d.GetOk("sbfm_definitely_automated"); ok {
+		config.SBFMDefinitelyAutomated = cloudflare.StringPtr(value.(string))
+	}
+
+	if value, ok := d.GetOk("sbfm_likely_automated"); ok {
+		config.SBFMLikelyAutomated = cloudflare.StringPtr(value.(string))
+	}
+
+	if value, ok := d.GetOk("sbfm_verified_bots"); ok {
+		config.SBFMVerifiedBots = cloudflare.StringPtr(value.(string))
+	}
+
+	//nolint:staticcheck
+	if value, ok := d.GetOkExists("sbfm_static_resource_protection"); ok {
+		config.SBFMStaticResourceProtection = cloudflare.BoolPtr(value.(bool))
+	}
+
+	//nolint:staticcheck
+	if value, ok := d.GetOkExists("optimize_wordpress"); ok {
+		config.OptimizeWordpress = cloudflare.BoolPtr(value.(bool))
+	}
+
+	return config
+}
@@ -0,0 +1,62 @@
+package sdkv2provider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccCloudflareBotManagement_SBFM(t *testing.T) {
+	rnd := generateRandomResourceName()
+	name := "cloudflare_bot_management." + rnd
+	zoneID := os.Getenv("CLOUDFLARE_ZONE_ID")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareBotManagementSBFMConfig(rnd, zoneID, "managed_challenge", "allow", false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "zone_id", zoneID),
+					resource.TestCheckResourceAttr(name, "sbfm_definitely_automated", "managed_challenge"),
+					resource.TestCheckResourceAttr(name, "sbfm_likely_automated", "managed_challenge"),
+					resource.TestCheckResourceAttr(name, "sbfm_verified_bots", "allow"),
+					resource.TestCheckResourceAttr(name, "sbfm_static_resource_protection", "false"),
+					resource.TestCheckResourceAttr(name, "optimize_wordpress", "false"),
+				),
+			},
+			{
+				Config: testAccCloudflareBotManagementSBFMConfig(rnd, zoneID, "block", "block", true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "zone_id", zoneID),
+					resource.TestCheckResourceAttr(name, "sbfm_definitely_automated", "block"),
+					resource.TestCheckResourceAttr(name, "sbfm_likely_automated", "block"),
+					resource.TestCheckResourceAttr(name, "sbfm_verified_bots", "block"),
+					resource.TestCheckResourceAttr(name, "sbfm_static_resource_protection", "true"),
+					resource.TestCheckResourceAttr(name, "optimize_wordpress", "true"),
+				),
+			},
+			{
+				ResourceName:      name,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCloudflareBotManagementSBFMConfig(rnd, zoneID, action, verifiedBots string, enabled bool) string {
+	return fmt.Sprintf(`
+resource "cloudflare_bot_management" "%[1]s" {
+	zone_id                         = "%[2]s"
+	sbfm_definitely_automated       = "%[3]s"
+	sbfm_likely_automated           = "%[3]s"
+	sbfm_verified_bots              = "%[4]s"
+	sbfm_static_resource_protection = %[5]t
+	optimize_wordpress              = %[5]t
+}
+`, rnd, zoneID, action, verifiedBots, enabled)
+}
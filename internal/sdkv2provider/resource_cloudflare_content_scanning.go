@@ -0,0 +1,108 @@
+package sdkv2provider
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/cloudflare/terraform-provider-cloudflare/internal/consts"
+	cloudflare "github.com/curtislarson/cloudflare-go"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// contentScanningSettings is the status of content scanning for a zone.
+type contentScanningSettings struct {
+	Value    string `json:"value"`
+	Modified string `json:"modified,omitempty"`
+}
+
+func resourceCloudflareContentScanning() *schema.Resource {
+	return &schema.Resource{
+		Schema:        resourceCloudflareContentScanningSchema(),
+		CreateContext: resourceCloudflareContentScanningUpdate,
+		ReadContext:   resourceCloudflareContentScanningRead,
+		UpdateContext: resourceCloudflareContentScanningUpdate,
+		DeleteContext: resourceCloudflareContentScanningDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceCloudflareContentScanningImport,
+		},
+		Description: heredoc.Doc(`
+			Provides a resource to manage WAF Content Scanning, which scans
+			uploaded content for malicious files.
+		`),
+	}
+}
+
+func resourceCloudflareContentScanningRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+
+	tflog.Info(ctx, fmt.Sprintf("Reading Content Scanning in zone %q", d.Id()))
+
+	res, err := client.Raw(ctx, http.MethodGet, fmt.Sprintf("/zones/%s/content-upload-scan/settings", d.Id()), nil, nil)
+	if err != nil {
+		var notFoundError *cloudflare.NotFoundError
+		if errors.As(err, &notFoundError) {
+			tflog.Info(ctx, fmt.Sprintf("Content Scanning for zone %q not found", d.Id()))
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("error reading content scanning for zone %q: %w", d.Id(), err))
+	}
+
+	var settings contentScanningSettings
+	if err := json.Unmarshal(res, &settings); err != nil {
+		return diag.FromErr(fmt.Errorf("failed to unmarshal content scanning response: %w", err))
+	}
+
+	d.Set(consts.ZoneIDSchemaKey, d.Id())
+	d.Set("enabled", settings.Value == "enabled")
+
+	return nil
+}
+
+func resourceCloudflareContentScanningUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	zoneID := d.Get(consts.ZoneIDSchemaKey).(string)
+	d.SetId(zoneID)
+
+	enabled := d.Get("enabled").(bool)
+	tflog.Info(ctx, fmt.Sprintf("Setting Content Scanning to %t for zone ID: %q", enabled, d.Id()))
+
+	if err := updateContentScanning(ctx, meta.(*cloudflare.API), zoneID, enabled); err != nil {
+		return diag.FromErr(fmt.Errorf("error setting content scanning for zone %q: %w", zoneID, err))
+	}
+
+	return resourceCloudflareContentScanningRead(ctx, d, meta)
+}
+
+func resourceCloudflareContentScanningDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	tflog.Info(ctx, fmt.Sprintf("Disabling Content Scanning for zone ID: %q", d.Id()))
+
+	if err := updateContentScanning(ctx, meta.(*cloudflare.API), d.Id(), false); err != nil {
+		return diag.FromErr(fmt.Errorf("error disabling content scanning for zone %q: %w", d.Id(), err))
+	}
+
+	return nil
+}
+
+func resourceCloudflareContentScanningImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	d.Set(consts.ZoneIDSchemaKey, d.Id())
+
+	resourceCloudflareContentScanningRead(ctx, d, meta)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func updateContentScanning(ctx context.Context, client *cloudflare.API, zoneID string, enabled bool) error {
+	action := "disable"
+	if enabled {
+		action = "enable"
+	}
+
+	_, err := client.Raw(ctx, http.MethodPost, fmt.Sprintf("/zones/%s/content-upload-scan/%s", zoneID, action), nil, nil)
+	return err
+}
@@ -0,0 +1,124 @@
+package sdkv2provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/cloudflare/terraform-provider-cloudflare/internal/consts"
+	cloudflare "github.com/curtislarson/cloudflare-go"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// contentScanningExpression is a custom scan expression.
+type contentScanningExpression struct {
+	ID      string `json:"id,omitempty"`
+	Payload string `json:"payload"`
+}
+
+func resourceCloudflareContentScanningExpression() *schema.Resource {
+	return &schema.Resource{
+		Schema:        resourceCloudflareContentScanningExpressionSchema(),
+		CreateContext: resourceCloudflareContentScanningExpressionCreate,
+		ReadContext:   resourceCloudflareContentScanningExpressionRead,
+		DeleteContext: resourceCloudflareContentScanningExpressionDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceCloudflareContentScanningExpressionImport,
+		},
+		Description: heredoc.Doc(`
+			Provides a resource to manage custom scan expressions for WAF
+			Content Scanning.
+		`),
+	}
+}
+
+func resourceCloudflareContentScanningExpressionCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	zoneID := d.Get(consts.ZoneIDSchemaKey).(string)
+	payload := d.Get("payload").(string)
+
+	tflog.Debug(ctx, fmt.Sprintf("Creating Cloudflare Content Scanning expression %q for zone %q", payload, zoneID))
+
+	res, err := client.Raw(ctx, http.MethodPost, fmt.Sprintf("/zones/%s/content-upload-scan/payloads", zoneID), []contentScanningExpression{{Payload: payload}}, nil)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error creating content scanning expression for zone %q: %w", zoneID, err))
+	}
+
+	var expressions []contentScanningExpression
+	if err := json.Unmarshal(res, &expressions); err != nil {
+		return diag.FromErr(fmt.Errorf("failed to unmarshal content scanning expressions response: %w", err))
+	}
+
+	if len(expressions) != 1 || expressions[0].ID == "" {
+		return diag.FromErr(fmt.Errorf("failed to find ID in Create response; resource was empty"))
+	}
+
+	d.SetId(expressions[0].ID)
+
+	return resourceCloudflareContentScanningExpressionRead(ctx, d, meta)
+}
+
+func resourceCloudflareContentScanningExpressionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	zoneID := d.Get(consts.ZoneIDSchemaKey).(string)
+
+	res, err := client.Raw(ctx, http.MethodGet, fmt.Sprintf("/zones/%s/content-upload-scan/payloads", zoneID), nil, nil)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error listing content scanning expressions for zone %q: %w", zoneID, err))
+	}
+
+	var expressions []contentScanningExpression
+	if err := json.Unmarshal(res, &expressions); err != nil {
+		return diag.FromErr(fmt.Errorf("failed to unmarshal content scanning expressions response: %w", err))
+	}
+
+	for _, expression := range expressions {
+		if expression.ID == d.Id() {
+			d.Set("payload", expression.Payload)
+			return nil
+		}
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("Content Scanning expression %q not found in zone %q", d.Id(), zoneID))
+	d.SetId("")
+
+	return nil
+}
+
+func resourceCloudflareContentScanningExpressionDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	zoneID := d.Get(consts.ZoneIDSchemaKey).(string)
+
+	tflog.Debug(ctx, fmt.Sprintf("Deleting Cloudflare Content Scanning expression %q for zone %q", d.Id(), zoneID))
+
+	_, err := client.Raw(ctx, http.MethodDelete, fmt.Sprintf("/zones/%s/content-upload-scan/payloads/%s", zoneID, d.Id()), nil, nil)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error deleting content scanning expression %q for zone %q: %w", d.Id(), zoneID, err))
+	}
+
+	return nil
+}
+
+func resourceCloudflareContentScanningExpressionImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	idAttr := strings.SplitN(d.Id(), "/", 2)
+
+	if len(idAttr) != 2 {
+		return nil, fmt.Errorf("invalid id (\"%s\") specified, should be in format \"zoneID/expressionID\"", d.Id())
+	}
+
+	zoneID, expressionID := idAttr[0], idAttr[1]
+
+	tflog.Debug(ctx, fmt.Sprintf("Importing Cloudflare Content Scanning expression: id %s for zone %s", expressionID, zoneID))
+
+	d.Set(consts.ZoneIDSchemaKey, zoneID)
+	d.SetId(expressionID)
+
+	resourceCloudflareContentScanningExpressionRead(ctx, d, meta)
+
+	return []*schema.ResourceData{d}, nil
+}
@@ -0,0 +1,77 @@
+package sdkv2provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+
+	cloudflare "github.com/curtislarson/cloudflare-go"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccCloudflareContentScanningExpression_Basic(t *testing.T) {
+	rnd := generateRandomResourceName()
+	name := "cloudflare_content_scanning_expression." + rnd
+	zoneID := os.Getenv("CLOUDFLARE_ZONE_ID")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareContentScanningExpressionConfig(rnd, zoneID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "zone_id", zoneID),
+					resource.TestCheckResourceAttr(name, "payload", fmt.Sprintf(`lookup_json_string(http.request.body.raw, "%s")`, rnd)),
+				),
+			},
+			{
+				ResourceName:        name,
+				ImportState:         true,
+				ImportStateIdPrefix: fmt.Sprintf("%s/", zoneID),
+				ImportStateVerify:   true,
+			},
+		},
+	})
+}
+
+func testAccCloudflareContentScanningExpressionConfig(rnd, zoneID string) string {
+	return fmt.Sprintf(`
+resource "cloudflare_content_scanning_expression" "%[1]s" {
+	zone_id = "%[2]s"
+	payload = "lookup_json_string(http.request.body.raw, \"%[1]s\")"
+}
+`, rnd, zoneID)
+}
+
+func TestResourceCloudflareContentScanningExpressionCreateUsesResponseID(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.Method == http.MethodPost {
+			fmt.Fprint(w, `{"success": true, "errors": [], "messages": [], "result": [{"id": "created", "payload": "lookup_json_string(http.request.body.raw, \"file\")"}]}`)
+			return
+		}
+		// An existing expression with the same payload must not be mistaken
+		// for the one just created.
+		fmt.Fprint(w, `{"success": true, "errors": [], "messages": [], "result": [{"id": "existing", "payload": "lookup_json_string(http.request.body.raw, \"file\")"}, {"id": "created", "payload": "lookup_json_string(http.request.body.raw, \"file\")"}]}`)
+	}))
+	defer server.Close()
+
+	config := Config{APIToken: "token", Options: []cloudflare.Option{cloudflare.BaseURL(server.URL), cloudflare.UsingRateLimit(1000)}}
+	client, err := config.Client(context.Background())
+	assert.NoError(t, err)
+
+	d := schema.TestResourceDataRaw(t, resourceCloudflareContentScanningExpressionSchema(), map[string]interface{}{
+		"zone_id": "zone",
+		"payload": `lookup_json_string(http.request.body.raw, "file")`,
+	})
+
+	diags := resourceCloudflareContentScanningExpressionCreate(context.Background(), d, client)
+	assert.False(t, diags.HasError())
+	assert.Equal(t, "created", d.Id())
+}
@@ -0,0 +1,373 @@
+package sdkv2provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/http"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/cloudflare/terraform-provider-cloudflare/internal/consts"
+	cloudflare "github.com/curtislarson/cloudflare-go"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// dnsRecordsBatch is the body of a request to the DNS records batch endpoint.
+type dnsRecordsBatch struct {
+	Deletes []dnsRecordsBatchDelete `json:"deletes,omitempty"`
+	Patches []dnsRecordsBatchRecord `json:"patches,omitempty"`
+	Posts   []dnsRecordsBatchRecord `json:"posts,omitempty"`
+}
+
+// dnsRecordsBatchDelete identifies a record to delete in a batch.
+type dnsRecordsBatchDelete struct {
+	ID string `json:"id"`
+}
+
+// dnsRecordsBatchRecord is the content of a record to create or patch in a
+// batch. The ID is only set for patches.
+type dnsRecordsBatchRecord struct {
+	ID       string  `json:"id,omitempty"`
+	Type     string  `json:"type"`
+	Name     string  `json:"name"`
+	Content  string  `json:"content"`
+	TTL      int     `json:"ttl"`
+	Proxied  *bool   `json:"proxied,omitempty"`
+	Priority *uint16 `json:"priority,omitempty"`
+	Comment  string  `json:"comment"`
+}
+
+// dnsRecordsBatchResult is the result of a response from the DNS records
+// batch endpoint.
+type dnsRecordsBatchResult struct {
+	Deletes []cloudflare.DNSRecord `json:"deletes"`
+	Patches []cloudflare.DNSRecord `json:"patches"`
+	Posts   []cloudflare.DNSRecord `json:"posts"`
+}
+
+// dnsRecordsBatchPriorityTypes are the record types which have a priority.
+var dnsRecordsBatchPriorityTypes = []string{"MX", "SRV", "URI"}
+
+func resourceCloudflareDNSRecordsBatch() *schema.Resource {
+	return &schema.Resource{
+		Schema:        resourceCloudflareDNSRecordsBatchSchema(),
+		CreateContext: resourceCloudflareDNSRecordsBatchCreate,
+		ReadContext:   resourceCloudflareDNSRecordsBatchRead,
+		UpdateContext: resourceCloudflareDNSRecordsBatchUpdate,
+		DeleteContext: resourceCloudflareDNSRecordsBatchDelete,
+		Description: heredoc.Doc(`
+			Provides a resource which manages a group of DNS records in a
+			single zone. All creates, updates and deletes of the records are
+			submitted to the DNS batch endpoint as one request so the change
+			is either applied in full or not at all.
+		`),
+	}
+}
+
+func resourceCloudflareDNSRecordsBatchCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if err := applyDNSRecordsBatch(ctx, d, meta, []interface{}{}, d.Get("record").([]interface{})); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(resource.UniqueId())
+
+	return resourceCloudflareDNSRecordsBatchRead(ctx, d, meta)
+}
+
+func resourceCloudflareDNSRecordsBatchRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	zoneID := d.Get(consts.ZoneIDSchemaKey).(string)
+
+	zoneRecords, _, err := client.ListDNSRecords(ctx, cloudflare.ZoneIdentifier(zoneID), cloudflare.ListDNSRecordsParams{})
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error listing DNS records for zone %q: %w", zoneID, err))
+	}
+
+	recordsByID := make(map[string]cloudflare.DNSRecord, len(zoneRecords))
+	for _, record := range zoneRecords {
+		recordsByID[record.ID] = record
+	}
+
+	records := make([]interface{}, 0)
+	for _, r := range d.Get("record").([]interface{}) {
+		m := r.(map[string]interface{})
+
+		record, ok := recordsByID[m["id"].(string)]
+		if !ok {
+			tflog.Warn(ctx, fmt.Sprintf("DNS record %q no longer exists in zone %q, removing it from the batch", m["id"], zoneID))
+			continue
+		}
+
+		flattened := flattenDNSRecordsBatchRecord(m["name"].(string), record)
+
+		// Keep the configured value when the API only normalized it, for
+		// example by compressing an IPv6 address or quoting TXT content.
+		if dnsRecordsBatchContentEqual(record.Type, m["value"].(string), record.Content) {
+			flattened["value"] = m["value"]
+		}
+
+		records = append(records, flattened)
+	}
+
+	if len(records) == 0 {
+		tflog.Info(ctx, fmt.Sprintf("No DNS records from the batch remain in zone %q", zoneID))
+		d.SetId("")
+		return nil
+	}
+
+	if err := d.Set("record", records); err != nil {
+		return diag.FromErr(fmt.Errorf("failed to set record: %w", err))
+	}
+
+	return nil
+}
+
+func resourceCloudflareDNSRecordsBatchUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if d.HasChange("record") {
+		o, n := d.GetChange("record")
+		if err := applyDNSRecordsBatch(ctx, d, meta, o.([]interface{}), n.([]interface{})); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceCloudflareDNSRecordsBatchRead(ctx, d, meta)
+}
+
+func resourceCloudflareDNSRecordsBatchDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	zoneID := d.Get(consts.ZoneIDSchemaKey).(string)
+
+	params, _ := dnsRecordsBatchDiff(d.Get("record").([]interface{}), []interface{}{})
+
+	tflog.Info(ctx, fmt.Sprintf("Deleting %d DNS records in zone %q", len(params.Deletes), zoneID))
+
+	if _, err := batchDNSRecords(ctx, client, zoneID, params); err != nil {
+		return diag.FromErr(fmt.Errorf("error deleting DNS records batch in zone %q: %w", zoneID, err))
+	}
+
+	return nil
+}
+
+// applyDNSRecordsBatch submits the changes required to move the zone from the
+// old set of records to the new one and stores the resulting record IDs.
+func applyDNSRecordsBatch(ctx context.Context, d *schema.ResourceData, meta interface{}, oldRecords, newRecords []interface{}) error {
+	client := meta.(*cloudflare.API)
+	zoneID := d.Get(consts.ZoneIDSchemaKey).(string)
+
+	params, ids := dnsRecordsBatchDiff(oldRecords, newRecords)
+
+	tflog.Debug(ctx, fmt.Sprintf("Cloudflare DNS records batch for zone %q: %d creates, %d updates, %d deletes", zoneID, len(params.Posts), len(params.Patches), len(params.Deletes)))
+
+	result, err := batchDNSRecords(ctx, client, zoneID, params)
+	if err != nil {
+		return fmt.Errorf("error applying DNS records batch in zone %q: %w", zoneID, err)
+	}
+
+	// Created records are returned in the order they were submitted so they
+	// can be matched back up with the configuration entries missing an ID.
+	created := 0
+	records := make([]interface{}, 0, len(newRecords))
+	for i, r := range newRecords {
+		m := r.(map[string]interface{})
+		if ids[i] == "" {
+			if created >= len(result.Posts) {
+				return fmt.Errorf("failed to find created record %q in DNS records batch response", m["name"])
+			}
+			ids[i] = result.Posts[created].ID
+			created++
+		}
+		m["id"] = ids[i]
+		records = append(records, m)
+	}
+
+	if err := d.Set("record", records); err != nil {
+		return fmt.Errorf("failed to set record: %w", err)
+	}
+
+	return nil
+}
+
+// batchDNSRecords submits a batch of DNS record changes to the zone.
+func batchDNSRecords(ctx context.Context, client *cloudflare.API, zoneID string, params dnsRecordsBatch) (dnsRecordsBatchResult, error) {
+	res, err := client.Raw(ctx, http.MethodPost, fmt.Sprintf("/zones/%s/dns_records/batch", zoneID), params, nil)
+	if err != nil {
+		return dnsRecordsBatchResult{}, err
+	}
+
+	var result dnsRecordsBatchResult
+	if err := json.Unmarshal(res, &result); err != nil {
+		return dnsRecordsBatchResult{}, fmt.Errorf("failed to unmarshal DNS records batch response: %w", err)
+	}
+
+	return result, nil
+}
+
+// dnsRecordsBatchDiff builds the batch request to reconcile the old records
+// with the new ones. Records are matched on type and name, preferring a record
+// with equivalent content when several share both. Matched records with other
+// changes are patched, unmatched old records are deleted and unmatched new
+// records are created. The returned slice holds the existing record ID for
+// each entry in newRecords, or an empty string when the record will be
+// created.
+func dnsRecordsBatchDiff(oldRecords, newRecords []interface{}) (dnsRecordsBatch, []string) {
+	params := dnsRecordsBatch{}
+	ids := make([]string, len(newRecords))
+	matched := make(map[string]bool)
+
+	existing := make(map[string][]map[string]interface{})
+	for _, r := range oldRecords {
+		m := r.(map[string]interface{})
+		if m["id"].(string) == "" {
+			continue
+		}
+		key := dnsRecordsBatchKey(m)
+		existing[key] = append(existing[key], m)
+	}
+
+	// Records with equivalent content are matched first so that changing one
+	// of several records sharing a type and name only patches that record.
+	olds := make([]map[string]interface{}, len(newRecords))
+	for i, r := range newRecords {
+		m := r.(map[string]interface{})
+		key := dnsRecordsBatchKey(m)
+		for j, o := range existing[key] {
+			if dnsRecordsBatchContentEqual(m["type"].(string), o["value"].(string), m["value"].(string)) {
+				olds[i] = o
+				existing[key] = append(existing[key][:j:j], existing[key][j+1:]...)
+				break
+			}
+		}
+	}
+
+	for i, r := range newRecords {
+		m := r.(map[string]interface{})
+		key := dnsRecordsBatchKey(m)
+
+		if olds[i] == nil && len(existing[key]) > 0 {
+			olds[i] = existing[key][0]
+			existing[key] = existing[key][1:]
+		}
+
+		if o := olds[i]; o != nil {
+			ids[i] = o["id"].(string)
+			matched[ids[i]] = true
+
+			if dnsRecordsBatchRecordChanged(o, m) {
+				record := expandDNSRecordsBatchRecord(m)
+				record.ID = ids[i]
+				params.Patches = append(params.Patches, record)
+			}
+			continue
+		}
+
+		params.Posts = append(params.Posts, expandDNSRecordsBatchRecord(m))
+	}
+
+	for _, r := range oldRecords {
+		m := r.(map[string]interface{})
+		if recordID := m["id"].(string); recordID != "" && !matched[recordID] {
+			params.Deletes = append(params.Deletes, dnsRecordsBatchDelete{ID: recordID})
+		}
+	}
+
+	return params, ids
+}
+
+func dnsRecordsBatchKey(m map[string]interface{}) string {
+	return fmt.Sprintf("%s/%s",
+		strings.ToUpper(m["type"].(string)),
+		strings.ToLower(strings.TrimSuffix(m["name"].(string), ".")),
+	)
+}
+
+// normalizeDNSRecordsBatchContent returns the content of a record in the form
+// the API stores it, so configured and returned values can be compared.
+func normalizeDNSRecordsBatchContent(recordType, content string) string {
+	content = strings.TrimSuffix(content, ".")
+
+	switch strings.ToUpper(recordType) {
+	case "A", "AAAA":
+		if ip := net.ParseIP(content); ip != nil {
+			return ip.String()
+		}
+	case "TXT", "SPF":
+		if len(content) >= 2 && strings.HasPrefix(content, `"`) && strings.HasSuffix(content, `"`) {
+			return content[1 : len(content)-1]
+		}
+	case "CNAME", "MX", "NS", "PTR":
+		return strings.ToLower(content)
+	}
+
+	return content
+}
+
+func dnsRecordsBatchContentEqual(recordType, a, b string) bool {
+	return normalizeDNSRecordsBatchContent(recordType, a) == normalizeDNSRecordsBatchContent(recordType, b)
+}
+
+// suppressDNSRecordsBatchEquivalentContent suppresses differences in a record
+// value which the API normalizes away.
+func suppressDNSRecordsBatchEquivalentContent(k, old, new string, d *schema.ResourceData) bool {
+	recordType := d.Get(strings.TrimSuffix(k, "value") + "type").(string)
+	return dnsRecordsBatchContentEqual(recordType, old, new)
+}
+
+func dnsRecordsBatchRecordChanged(o, n map[string]interface{}) bool {
+	if !dnsRecordsBatchContentEqual(n["type"].(string), o["value"].(string), n["value"].(string)) {
+		return true
+	}
+
+	if ttl := n["ttl"].(int); ttl != 0 && ttl != o["ttl"].(int) {
+		return true
+	}
+
+	return o["proxied"].(bool) != n["proxied"].(bool) ||
+		o["priority"].(int) != n["priority"].(int) ||
+		o["comment"].(string) != n["comment"].(string)
+}
+
+func expandDNSRecordsBatchRecord(m map[string]interface{}) dnsRecordsBatchRecord {
+	record := dnsRecordsBatchRecord{
+		Type:    m["type"].(string),
+		Name:    m["name"].(string),
+		Content: m["value"].(string),
+		TTL:     m["ttl"].(int),
+		Proxied: cloudflare.BoolPtr(m["proxied"].(bool)),
+		Comment: m["comment"].(string),
+	}
+
+	// Automatic TTL is used when none is configured.
+	if record.TTL == 0 {
+		record.TTL = 1
+	}
+
+	if contains(dnsRecordsBatchPriorityTypes, record.Type) {
+		p := uint16(m["priority"].(int))
+		record.Priority = &p
+	}
+
+	return record
+}
+
+func flattenDNSRecordsBatchRecord(name string, record cloudflare.DNSRecord) map[string]interface{} {
+	m := map[string]interface{}{
+		"id":      record.ID,
+		"name":    name,
+		"type":    record.Type,
+		"value":   record.Content,
+		"ttl":     record.TTL,
+		"proxied": cloudflare.Bool(record.Proxied),
+		"comment": record.Comment,
+	}
+
+	if record.Priority != nil {
+		m["priority"] = int(*record.Priority)
+	}
+
+	return m
+}
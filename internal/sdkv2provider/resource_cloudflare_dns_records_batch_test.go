@@ -0,0 +1,222 @@
+package sdkv2provider
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	cloudflare "github.com/curtislarson/cloudflare-go"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccCloudflareDNSRecordsBatch_Basic(t *testing.T) {
+	t.Parallel()
+	zoneID := os.Getenv("CLOUDFLARE_ZONE_ID")
+	rnd := generateRandomResourceName()
+	name := fmt.Sprintf("cloudflare_dns_records_batch.%s", rnd)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		CheckDestroy:      testAccCheckCloudflareDNSRecordsBatchDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareDNSRecordsBatchConfig(zoneID, rnd, "192.168.0.10"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "zone_id", zoneID),
+					resource.TestCheckResourceAttr(name, "record.#", "2"),
+					resource.TestCheckResourceAttr(name, "record.0.name", fmt.Sprintf("%s-a", rnd)),
+					resource.TestCheckResourceAttr(name, "record.0.value", "192.168.0.10"),
+					resource.TestCheckResourceAttr(name, "record.0.ttl", "3600"),
+					resource.TestCheckResourceAttrSet(name, "record.0.id"),
+					resource.TestCheckResourceAttr(name, "record.1.name", fmt.Sprintf("%s-txt", rnd)),
+					resource.TestCheckResourceAttr(name, "record.1.value", "hello"),
+					resource.TestCheckResourceAttrSet(name, "record.1.id"),
+				),
+			},
+			{
+				Config: testAccCloudflareDNSRecordsBatchConfig(zoneID, rnd, "192.168.0.11"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "record.#", "2"),
+					resource.TestCheckResourceAttr(name, "record.0.value", "192.168.0.11"),
+					resource.TestCheckResourceAttr(name, "record.1.value", "hello"),
+				),
+			},
+		},
+	})
+}
+
+func TestDNSRecordsBatchDiff(t *testing.T) {
+	t.Parallel()
+
+	record := func(id, recordType, name, value string, ttl int) map[string]interface{} {
+		return map[string]interface{}{
+			"id":       id,
+			"type":     recordType,
+			"name":     name,
+			"value":    value,
+			"ttl":      ttl,
+			"proxied":  false,
+			"priority": 0,
+			"comment":  "",
+		}
+	}
+
+	oldRecords := []interface{}{
+		record("1", "A", "www", "192.0.2.1", 300),
+		record("2", "A", "api", "192.0.2.2", 300),
+		record("3", "TXT", "txt", "hello", 300),
+		record("4", "AAAA", "v6", "2001:db8::1", 300),
+		record("5", "CNAME", "old", "example.com", 300),
+	}
+	newRecords := []interface{}{
+		record("", "TXT", "txt", "\"hello\"", 300),
+		record("", "A", "WWW", "192.0.2.1", 600),
+		record("", "A", "api", "192.0.2.3", 300),
+		record("", "AAAA", "v6", "2001:0db8:0000:0000:0000:0000:0000:0001", 300),
+		record("", "CNAME", "new", "example.com", 300),
+	}
+
+	params, ids := dnsRecordsBatchDiff(oldRecords, newRecords)
+
+	assert.Equal(t, []string{"3", "1", "2", "4", ""}, ids)
+
+	assert.Len(t, params.Patches, 2)
+	assert.Equal(t, "1", params.Patches[0].ID)
+	assert.Equal(t, 600, params.Patches[0].TTL)
+	assert.Equal(t, "2", params.Patches[1].ID)
+	assert.Equal(t, "192.0.2.3", params.Patches[1].Content)
+
+	assert.Len(t, params.Posts, 1)
+	assert.Equal(t, "new", params.Posts[0].Name)
+
+	assert.Equal(t, []dnsRecordsBatchDelete{{ID: "5"}}, params.Deletes)
+}
+
+func TestDNSRecordsBatchDiffMatchesEquivalentContent(t *testing.T) {
+	t.Parallel()
+
+	record := func(id, value string) map[string]interface{} {
+		return map[string]interface{}{
+			"id":       id,
+			"type":     "A",
+			"name":     "www",
+			"value":    value,
+			"ttl":      300,
+			"proxied":  false,
+			"priority": 0,
+			"comment":  "",
+		}
+	}
+
+	oldRecords := []interface{}{
+		record("1", "192.0.2.1"),
+		record("2", "192.0.2.2"),
+	}
+	newRecords := []interface{}{
+		record("", "192.0.2.2"),
+		record("", "192.0.2.3"),
+	}
+
+	params, ids := dnsRecordsBatchDiff(oldRecords, newRecords)
+
+	assert.Equal(t, []string{"2", "1"}, ids)
+	assert.Len(t, params.Patches, 1)
+	assert.Equal(t, "1", params.Patches[0].ID)
+	assert.Equal(t, "192.0.2.3", params.Patches[0].Content)
+	assert.Empty(t, params.Posts)
+	assert.Empty(t, params.Deletes)
+}
+
+func TestNormalizeDNSRecordsBatchContent(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		recordType string
+		content    string
+		expected   string
+	}{
+		"compressed IPv6":    {"AAAA", "2001:0db8:0000:0000:0000:0000:0000:0001", "2001:db8::1"},
+		"quoted TXT":         {"TXT", "\"v=spf1 -all\"", "v=spf1 -all"},
+		"unquoted TXT":       {"TXT", "v=spf1 -all", "v=spf1 -all"},
+		"trailing dot CNAME": {"CNAME", "Example.com.", "example.com"},
+		"case sensitive TXT": {"TXT", "Hello", "Hello"},
+		"invalid A":          {"A", "not-an-ip", "not-an-ip"},
+	}
+
+	for name, tc := range testCases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tc.expected, normalizeDNSRecordsBatchContent(tc.recordType, tc.content))
+		})
+	}
+}
+
+func TestExpandDNSRecordsBatchRecordPriority(t *testing.T) {
+	t.Parallel()
+
+	for _, recordType := range []string{"MX", "SRV", "URI", "A"} {
+		record := expandDNSRecordsBatchRecord(map[string]interface{}{
+			"type":     recordType,
+			"name":     "example",
+			"value":    "example.com",
+			"ttl":      0,
+			"proxied":  false,
+			"priority": 10,
+			"comment":  "",
+		})
+
+		if recordType == "A" {
+			assert.Nil(t, record.Priority)
+			continue
+		}
+
+		if assert.NotNil(t, record.Priority, recordType) {
+			assert.Equal(t, uint16(10), *record.Priority, recordType)
+		}
+	}
+}
+
+func testAccCheckCloudflareDNSRecordsBatchDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*cloudflare.API)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "cloudflare_dns_records_batch" {
+			continue
+		}
+
+		for i := 0; i < 2; i++ {
+			recordID := rs.Primary.Attributes[fmt.Sprintf("record.%d.id", i)]
+			_, err := client.GetDNSRecord(context.Background(), cloudflare.ZoneIdentifier(rs.Primary.Attributes["zone_id"]), recordID)
+			if err == nil {
+				return fmt.Errorf("DNS record %s still exists", recordID)
+			}
+		}
+	}
+
+	return nil
+}
+
+func testAccCloudflareDNSRecordsBatchConfig(zoneID, name, value string) string {
+	return fmt.Sprintf(`
+resource "cloudflare_dns_records_batch" "%[1]s" {
+  zone_id = "%[2]s"
+
+  record {
+    name  = "%[1]s-a"
+    type  = "A"
+    value = "%[3]s"
+    ttl   = 3600
+  }
+
+  record {
+    name  = "%[1]s-txt"
+    type  = "TXT"
+    value = "hello"
+  }
+}`, name, zoneID, value)
+}
@@ -0,0 +1,103 @@
+package sdkv2provider
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/cloudflare/terraform-provider-cloudflare/internal/consts"
+	cloudflare "github.com/curtislarson/cloudflare-go"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// leakedCredentialCheck is the status of leaked credential detection for a
+// zone.
+type leakedCredentialCheck struct {
+	Enabled bool `json:"enabled"`
+}
+
+func resourceCloudflareLeakedCredentialCheck() *schema.Resource {
+	return &schema.Resource{
+		Schema:        resourceCloudflareLeakedCredentialCheckSchema(),
+		CreateContext: resourceCloudflareLeakedCredentialCheckUpdate,
+		ReadContext:   resourceCloudflareLeakedCredentialCheckRead,
+		UpdateContext: resourceCloudflareLeakedCredentialCheckUpdate,
+		DeleteContext: resourceCloudflareLeakedCredentialCheckDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceCloudflareLeakedCredentialCheckImport,
+		},
+		Description: heredoc.Doc(`
+			Provides a resource to manage the Leaked Credential Check
+			detection of a zone.
+		`),
+	}
+}
+
+func resourceCloudflareLeakedCredentialCheckRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+
+	tflog.Info(ctx, fmt.Sprintf("Reading Leaked Credential Check in zone %q", d.Id()))
+
+	res, err := client.Raw(ctx, http.MethodGet, fmt.Sprintf("/zones/%s/leaked-credential-checks", d.Id()), nil, nil)
+	if err != nil {
+		var notFoundError *cloudflare.NotFoundError
+		if errors.As(err, &notFoundError) {
+			tflog.Info(ctx, fmt.Sprintf("Leaked Credential Check for zone %q not found", d.Id()))
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("error reading leaked credential check for zone %q: %w", d.Id(), err))
+	}
+
+	var status leakedCredentialCheck
+	if err := json.Unmarshal(res, &status); err != nil {
+		return diag.FromErr(fmt.Errorf("failed to unmarshal leaked credential check response: %w", err))
+	}
+
+	d.Set(consts.ZoneIDSchemaKey, d.Id())
+	d.Set("enabled", status.Enabled)
+
+	return nil
+}
+
+func resourceCloudflareLeakedCredentialCheckUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	zoneID := d.Get(consts.ZoneIDSchemaKey).(string)
+	d.SetId(zoneID)
+
+	enabled := d.Get("enabled").(bool)
+	tflog.Info(ctx, fmt.Sprintf("Setting Leaked Credential Check to %t for zone ID: %q", enabled, d.Id()))
+
+	if err := updateLeakedCredentialCheck(ctx, meta.(*cloudflare.API), zoneID, enabled); err != nil {
+		return diag.FromErr(fmt.Errorf("error setting leaked credential check for zone %q: %w", zoneID, err))
+	}
+
+	return resourceCloudflareLeakedCredentialCheckRead(ctx, d, meta)
+}
+
+func resourceCloudflareLeakedCredentialCheckDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	tflog.Info(ctx, fmt.Sprintf("Disabling Leaked Credential Check for zone ID: %q", d.Id()))
+
+	if err := updateLeakedCredentialCheck(ctx, meta.(*cloudflare.API), d.Id(), false); err != nil {
+		return diag.FromErr(fmt.Errorf("error disabling leaked credential check for zone %q: %w", d.Id(), err))
+	}
+
+	return nil
+}
+
+func resourceCloudflareLeakedCredentialCheckImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	d.Set(consts.ZoneIDSchemaKey, d.Id())
+
+	resourceCloudflareLeakedCredentialCheckRead(ctx, d, meta)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func updateLeakedCredentialCheck(ctx context.Context, client *cloudflare.API, zoneID string, enabled bool) error {
+	_, err := client.Raw(ctx, http.MethodPost, fmt.Sprintf("/zones/%s/leaked-credential-checks", zoneID), leakedCredentialCheck{Enabled: enabled}, nil)
+	return err
+}
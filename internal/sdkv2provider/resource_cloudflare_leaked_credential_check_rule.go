@@ -0,0 +1,152 @@
+package sdkv2provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/cloudflare/terraform-provider-cloudflare/internal/consts"
+	cloudflare "github.com/curtislarson/cloudflare-go"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// leakedCredentialCheckRule is a custom detection location for leaked
+// credentials.
+type leakedCredentialCheckRule struct {
+	ID       string `json:"id,omitempty"`
+	Username string `json:"username"`
+	Password string `json:"password"`
+}
+
+func resourceCloudflareLeakedCredentialCheckRule() *schema.Resource {
+	return &schema.Resource{
+		Schema:        resourceCloudflareLeakedCredentialCheckRuleSchema(),
+		CreateContext: resourceCloudflareLeakedCredentialCheckRuleCreate,
+		ReadContext:   resourceCloudflareLeakedCredentialCheckRuleRead,
+		UpdateContext: resourceCloudflareLeakedCredentialCheckRuleUpdate,
+		DeleteContext: resourceCloudflareLeakedCredentialCheckRuleDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceCloudflareLeakedCredentialCheckRuleImport,
+		},
+		Description: heredoc.Doc(`
+			Provides a resource to manage custom Leaked Credential Check
+			detection rules, which tell Cloudflare where to find the username
+			and password in a request.
+		`),
+	}
+}
+
+func resourceCloudflareLeakedCredentialCheckRuleCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	zoneID := d.Get(consts.ZoneIDSchemaKey).(string)
+
+	rule := leakedCredentialCheckRule{
+		Username: d.Get("username").(string),
+		Password: d.Get("password").(string),
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Creating Cloudflare Leaked Credential Check rule for zone %q from struct: %+v", zoneID, rule))
+
+	res, err := client.Raw(ctx, http.MethodPost, fmt.Sprintf("/zones/%s/leaked-credential-checks/detections", zoneID), rule, nil)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error creating leaked credential check rule for zone %q: %w", zoneID, err))
+	}
+
+	if err := json.Unmarshal(res, &rule); err != nil {
+		return diag.FromErr(fmt.Errorf("failed to unmarshal leaked credential check rule response: %w", err))
+	}
+
+	if rule.ID == "" {
+		return diag.FromErr(fmt.Errorf("failed to find ID in Create response; resource was empty"))
+	}
+
+	d.SetId(rule.ID)
+
+	return resourceCloudflareLeakedCredentialCheckRuleRead(ctx, d, meta)
+}
+
+func resourceCloudflareLeakedCredentialCheckRuleRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	zoneID := d.Get(consts.ZoneIDSchemaKey).(string)
+
+	res, err := client.Raw(ctx, http.MethodGet, fmt.Sprintf("/zones/%s/leaked-credential-checks/detections", zoneID), nil, nil)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error listing leaked credential check rules for zone %q: %w", zoneID, err))
+	}
+
+	var rules []leakedCredentialCheckRule
+	if err := json.Unmarshal(res, &rules); err != nil {
+		return diag.FromErr(fmt.Errorf("failed to unmarshal leaked credential check rules response: %w", err))
+	}
+
+	for _, rule := range rules {
+		if rule.ID == d.Id() {
+			d.Set("username", rule.Username)
+			d.Set("password", rule.Password)
+			return nil
+		}
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("Leaked Credential Check rule %q not found in zone %q", d.Id(), zoneID))
+	d.SetId("")
+
+	return nil
+}
+
+func resourceCloudflareLeakedCredentialCheckRuleUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	zoneID := d.Get(consts.ZoneIDSchemaKey).(string)
+
+	rule := leakedCredentialCheckRule{
+		ID:       d.Id(),
+		Username: d.Get("username").(string),
+		Password: d.Get("password").(string),
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Updating Cloudflare Leaked Credential Check rule for zone %q from struct: %+v", zoneID, rule))
+
+	_, err := client.Raw(ctx, http.MethodPut, fmt.Sprintf("/zones/%s/leaked-credential-checks/detections/%s", zoneID, d.Id()), rule, nil)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error updating leaked credential check rule %q for zone %q: %w", d.Id(), zoneID, err))
+	}
+
+	return resourceCloudflareLeakedCredentialCheckRuleRead(ctx, d, meta)
+}
+
+func resourceCloudflareLeakedCredentialCheckRuleDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	zoneID := d.Get(consts.ZoneIDSchemaKey).(string)
+
+	tflog.Debug(ctx, fmt.Sprintf("Deleting Cloudflare Leaked Credential Check rule %q for zone %q", d.Id(), zoneID))
+
+	_, err := client.Raw(ctx, http.MethodDelete, fmt.Sprintf("/zones/%s/leaked-credential-checks/detections/%s", zoneID, d.Id()), nil, nil)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error deleting leaked credential check rule %q for zone %q: %w", d.Id(), zoneID, err))
+	}
+
+	return nil
+}
+
+func resourceCloudflareLeakedCredentialCheckRuleImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	idAttr := strings.SplitN(d.Id(), "/", 2)
+
+	if len(idAttr) != 2 {
+		return nil, fmt.Errorf("invalid id (\"%s\") specified, should be in format \"zoneID/ruleID\"", d.Id())
+	}
+
+	zoneID, ruleID := idAttr[0], idAttr[1]
+
+	tflog.Debug(ctx, fmt.Sprintf("Importing Cloudflare Leaked Credential Check rule: id %s for zone %s", ruleID, zoneID))
+
+	d.Set(consts.ZoneIDSchemaKey, zoneID)
+	d.SetId(ruleID)
+
+	resourceCloudflareLeakedCredentialCheckRuleRead(ctx, d, meta)
+
+	return []*schema.ResourceData{d}, nil
+}
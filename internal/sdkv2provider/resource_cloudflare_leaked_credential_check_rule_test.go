@@ -0,0 +1,86 @@
+package sdkv2provider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccCloudflareLeakedCredentialCheckRule_Basic(t *testing.T) {
+	rnd := generateRandomResourceName()
+	name := "cloudflare_leaked_credential_check_rule." + rnd
+	zoneID := os.Getenv("CLOUDFLARE_ZONE_ID")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareLeakedCredentialCheckRuleConfig(rnd, zoneID, "user"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "zone_id", zoneID),
+					resource.TestCheckResourceAttr(name, "username", `lookup_json_string(http.request.body.raw, "user")`),
+					resource.TestCheckResourceAttr(name, "password", `lookup_json_string(http.request.body.raw, "secret")`),
+				),
+			},
+			{
+				Config: testAccCloudflareLeakedCredentialCheckRuleConfig(rnd, zoneID, "email"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "username", `lookup_json_string(http.request.body.raw, "email")`),
+				),
+			},
+			{
+				ResourceName:        name,
+				ImportState:         true,
+				ImportStateIdPrefix: fmt.Sprintf("%s/", zoneID),
+				ImportStateVerify:   true,
+			},
+		},
+	})
+}
+
+func TestValidateExpressionSyntax(t *testing.T) {
+	testCases := map[string]struct {
+		expression string
+		valid      bool
+	}{
+		"lookup":              {expression: `lookup_json_string(http.request.body.raw, "user")`, valid: true},
+		"form field":          {expression: `http.request.body.form["password"][0]`, valid: true},
+		"escaped quote":       {expression: `lookup_json_string(http.request.body.raw, "us\"er")`, valid: true},
+		"bracket in string":   {expression: `lookup_json_string(http.request.body.raw, "user)")`, valid: true},
+		"empty":               {expression: "  ", valid: false},
+		"unclosed paren":      {expression: `lookup_json_string(http.request.body.raw, "user"`, valid: false},
+		"mismatched bracket":  {expression: `http.request.body.form["password")`, valid: false},
+		"unterminated string": {expression: `lookup_json_string(http.request.body.raw, "user)`, valid: false},
+		"unexpected close":    {expression: `http.request.body.raw)`, valid: false},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			_, errs := validateExpressionSyntax(tc.expression, "username")
+			if tc.valid && len(errs) > 0 {
+				t.Errorf("expected expression to be valid, got: %v", errs)
+			}
+			if !tc.valid && len(errs) == 0 {
+				t.Errorf("expected expression to be invalid")
+			}
+		})
+	}
+}
+
+func testAccCloudflareLeakedCredentialCheckRuleConfig(rnd, zoneID, usernameField string) string {
+	return fmt.Sprintf(`
+resource "cloudflare_leaked_credential_check" "%[1]s" {
+	zone_id = "%[2]s"
+	enabled = true
+}
+
+resource "cloudflare_leaked_credential_check_rule" "%[1]s" {
+	zone_id  = cloudflare_leaked_credential_check.%[1]s.zone_id
+	username = "lookup_json_string(http.request.body.raw, \"%[3]s\")"
+	password = "lookup_json_string(http.request.body.raw, \"secret\")"
+}
+`, rnd, zoneID, usernameField)
+}
@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"net/http"
 	"regexp"
 	"strconv"
 	"strings"
@@ -92,6 +93,46 @@ func getJobFromResource(d *schema.ResourceData) (cloudflare.LogpushJob, *AccessI
 	return job, identifier, nil
 }
 
+// logpushJobUploadLimits holds the batch size settings of a Logpush job. These
+// aren't part of cloudflare.LogpushJob so they are managed directly against
+// the job endpoint.
+type logpushJobUploadLimits struct {
+	MaxUploadBytes           int `json:"max_upload_bytes"`
+	MaxUploadRecords         int `json:"max_upload_records"`
+	MaxUploadIntervalSeconds int `json:"max_upload_interval_seconds"`
+}
+
+func getLogpushJobUploadLimitsFromResource(d *schema.ResourceData) logpushJobUploadLimits {
+	return logpushJobUploadLimits{
+		MaxUploadBytes:           d.Get("max_upload_bytes").(int),
+		MaxUploadRecords:         d.Get("max_upload_records").(int),
+		MaxUploadIntervalSeconds: d.Get("max_upload_interval_seconds").(int),
+	}
+}
+
+func logpushJobEndpoint(identifier *AccessIdentifier, jobID int) string {
+	return fmt.Sprintf("/%ss/%s/logpush/jobs/%d", identifier.Type, identifier.Value, jobID)
+}
+
+func getLogpushJobUploadLimits(ctx context.Context, client *cloudflare.API, identifier *AccessIdentifier, jobID int) (logpushJobUploadLimits, error) {
+	res, err := client.Raw(ctx, http.MethodGet, logpushJobEndpoint(identifier, jobID), nil, nil)
+	if err != nil {
+		return logpushJobUploadLimits{}, err
+	}
+
+	var limits logpushJobUploadLimits
+	if err := json.Unmarshal(res, &limits); err != nil {
+		return logpushJobUploadLimits{}, err
+	}
+
+	return limits, nil
+}
+
+func updateLogpushJobUploadLimits(ctx context.Context, client *cloudflare.API, identifier *AccessIdentifier, jobID int, limits logpushJobUploadLimits) error {
+	_, err := client.Raw(ctx, http.MethodPut, logpushJobEndpoint(identifier, jobID), limits, nil)
+	return err
+}
+
 func resourceCloudflareLogpushJobRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*cloudflare.API)
 	jobID, err := strconv.Atoi(d.Id())
@@ -124,6 +165,11 @@ func resourceCloudflareLogpushJobRead(ctx context.Context, d *schema.ResourceDat
 		return nil
 	}
 
+	limits, err := getLogpushJobUploadLimits(ctx, client, identifier, jobID)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error reading upload limits of logpush job %q for %s: %w", jobID, identifier, err))
+	}
+
 	var filter string
 
 	if job.Filter != nil {
@@ -142,6 +188,9 @@ func resourceCloudflareLogpushJobRead(ctx context.Context, d *schema.ResourceDat
 	d.Set("destination_conf", job.DestinationConf)
 	d.Set("ownership_challenge", d.Get("ownership_challenge"))
 	d.Set("frequency", job.Frequency)
+	d.Set("max_upload_bytes", limits.MaxUploadBytes)
+	d.Set("max_upload_records", limits.MaxUploadRecords)
+	d.Set("max_upload_interval_seconds", limits.MaxUploadIntervalSeconds)
 	d.Set("filter", filter)
 
 	return nil
@@ -172,6 +221,12 @@ func resourceCloudflareLogpushJobCreate(ctx context.Context, d *schema.ResourceD
 
 	d.SetId(strconv.Itoa(j.ID))
 
+	if limits := getLogpushJobUploadLimitsFromResource(d); limits != (logpushJobUploadLimits{}) {
+		if err := updateLogpushJobUploadLimits(ctx, client, identifier, j.ID, limits); err != nil {
+			return diag.FromErr(fmt.Errorf("error setting upload limits of logpush job id %q for %s: %w", j.ID, identifier, err))
+		}
+	}
+
 	tflog.Info(ctx, fmt.Sprintf("Created Cloudflare Logpush Job for %s: %s", identifier, d.Id()))
 
 	return resourceCloudflareLogpushJobRead(ctx, d, meta)
@@ -197,6 +252,12 @@ func resourceCloudflareLogpushJobUpdate(ctx context.Context, d *schema.ResourceD
 		return diag.FromErr(fmt.Errorf("error updating logpush job id %q for %s: %w", job.ID, identifier, err))
 	}
 
+	if d.HasChanges("max_upload_bytes", "max_upload_records", "max_upload_interval_seconds") {
+		if err := updateLogpushJobUploadLimits(ctx, client, identifier, job.ID, getLogpushJobUploadLimitsFromResource(d)); err != nil {
+			return diag.FromErr(fmt.Errorf("error updating upload limits of logpush job id %q for %s: %w", job.ID, identifier, err))
+		}
+	}
+
 	return resourceCloudflareLogpushJobRead(ctx, d, meta)
 }
 
@@ -0,0 +1,83 @@
+package sdkv2provider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccCloudflareLogpushJob_UploadLimits(t *testing.T) {
+	rnd := generateRandomResourceName()
+	name := "cloudflare_logpush_job." + rnd
+	zoneID := os.Getenv("CLOUDFLARE_ZONE_ID")
+	token := os.Getenv("CLOUDFLARE_LOGPUSH_OWNERSHIP_TOKEN")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckLogpushToken(t)
+		},
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareLogpushJobUploadLimitsConfig(rnd, zoneID, token, 5000000, 1000, 30),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "max_upload_bytes", "5000000"),
+					resource.TestCheckResourceAttr(name, "max_upload_records", "1000"),
+					resource.TestCheckResourceAttr(name, "max_upload_interval_seconds", "30"),
+				),
+			},
+			{
+				Config: testAccCloudflareLogpushJobUploadLimitsConfig(rnd, zoneID, token, 10000000, 5000, 300),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "max_upload_bytes", "10000000"),
+					resource.TestCheckResourceAttr(name, "max_upload_records", "5000"),
+					resource.TestCheckResourceAttr(name, "max_upload_interval_seconds", "300"),
+				),
+			},
+		},
+	})
+}
+
+func TestGetLogpushJobUploadLimitsFromResource(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, resourceCloudflareLogpushJobSchema(), map[string]interface{}{
+		"dataset":                     "http_requests",
+		"destination_conf":            "gs://cf-terraform-provider-acct-test/logs",
+		"max_upload_bytes":            5000000,
+		"max_upload_records":          1000,
+		"max_upload_interval_seconds": 30,
+	})
+
+	assert.Equal(t, logpushJobUploadLimits{
+		MaxUploadBytes:           5000000,
+		MaxUploadRecords:         1000,
+		MaxUploadIntervalSeconds: 30,
+	}, getLogpushJobUploadLimitsFromResource(d))
+
+	d = schema.TestResourceDataRaw(t, resourceCloudflareLogpushJobSchema(), map[string]interface{}{
+		"dataset":          "http_requests",
+		"destination_conf": "gs://cf-terraform-provider-acct-test/logs",
+	})
+
+	assert.Equal(t, logpushJobUploadLimits{}, getLogpushJobUploadLimitsFromResource(d))
+}
+
+func testAccCloudflareLogpushJobUploadLimitsConfig(rnd, zoneID, token string, maxBytes, maxRecords, maxInterval int) string {
+	return fmt.Sprintf(`
+resource "cloudflare_logpush_job" "%[1]s" {
+	zone_id                     = "%[2]s"
+	name                        = "%[1]s"
+	dataset                     = "http_requests"
+	logpull_options             = "fields=RayID,ClientIP,EdgeStartTimestamp&timestamps=rfc3339"
+	destination_conf            = "gs://cf-terraform-provider-acct-test/logs"
+	ownership_challenge         = "%[3]s"
+	max_upload_bytes            = %[4]d
+	max_upload_records          = %[5]d
+	max_upload_interval_seconds = %[6]d
+}
+`, rnd, zoneID, token, maxBytes, maxRecords, maxInterval)
+}
@@ -0,0 +1,162 @@
+package sdkv2provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/cloudflare/terraform-provider-cloudflare/internal/consts"
+	cloudflare "github.com/curtislarson/cloudflare-go"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceCloudflareMTLSCertificate() *schema.Resource {
+	return &schema.Resource{
+		Schema:        resourceCloudflareMTLSCertificateSchema(),
+		CreateContext: resourceCloudflareMTLSCertificateCreate,
+		ReadContext:   resourceCloudflareMTLSCertificateRead,
+		DeleteContext: resourceCloudflareMTLSCertificateDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceCloudflareMTLSCertificateImport,
+		},
+		Description: heredoc.Doc(`
+			Provides a Cloudflare mTLS certificate resource. These certificates
+			may be used with mTLS enabled Cloudflare services such as API Shield
+			and Access.
+		`),
+	}
+}
+
+func resourceCloudflareMTLSCertificateCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	accountID := d.Get(consts.AccountIDSchemaKey).(string)
+
+	params := cloudflare.CreateMTLSCertificateParams{
+		Name:         d.Get("name").(string),
+		Certificates: d.Get("certificates").(string),
+		PrivateKey:   d.Get("private_key").(string),
+		CA:           d.Get("ca").(bool),
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Creating Cloudflare mTLS certificate %q for account %q", params.Name, accountID))
+
+	certificate, err := client.CreateMTLSCertificate(ctx, cloudflare.AccountIdentifier(accountID), params)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error creating mTLS certificate for account %q: %w", accountID, err))
+	}
+
+	d.SetId(certificate.ID)
+
+	return resourceCloudflareMTLSCertificateRead(ctx, d, meta)
+}
+
+func resourceCloudflareMTLSCertificateRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	accountID := d.Get(consts.AccountIDSchemaKey).(string)
+
+	certificate, err := client.GetMTLSCertificate(ctx, cloudflare.AccountIdentifier(accountID), d.Id())
+	if err != nil {
+		var notFoundError *cloudflare.NotFoundError
+		if errors.As(err, &notFoundError) {
+			tflog.Info(ctx, fmt.Sprintf("mTLS certificate %s no longer exists", d.Id()))
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("error finding mTLS certificate %q: %w", d.Id(), err))
+	}
+
+	d.Set("name", certificate.Name)
+	// The API may return the certificates re-encoded, so the configured PEM
+	// is kept unless there is none, such as after an import.
+	if d.Get("certificates").(string) == "" {
+		d.Set("certificates", certificate.Certificates)
+	}
+	d.Set("ca", certificate.CA)
+	d.Set("issuer", certificate.Issuer)
+	d.Set("signature", certificate.Signature)
+	d.Set("serial_number", certificate.SerialNumber)
+	d.Set("uploaded_on", certificate.UploadedOn.Format(time.RFC3339Nano))
+	d.Set("expires_on", certificate.ExpiresOn.Format(time.RFC3339Nano))
+
+	return nil
+}
+
+func resourceCloudflareMTLSCertificateDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	accountID := d.Get(consts.AccountIDSchemaKey).(string)
+
+	associations, err := client.ListMTLSCertificateAssociations(ctx, cloudflare.AccountIdentifier(accountID), cloudflare.ListMTLSCertificateAssociationsParams{
+		CertificateID: d.Id(),
+	})
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error listing associations of mTLS certificate %q: %w", d.Id(), err))
+	}
+
+	if len(associations) > 0 {
+		services := make([]string, 0, len(associations))
+		for _, association := range associations {
+			services = append(services, association.Service)
+		}
+		return diag.FromErr(fmt.Errorf("mTLS certificate %q is still associated with the following services and cannot be deleted: %s", d.Id(), strings.Join(services, ", ")))
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Deleting Cloudflare mTLS certificate %q for account %q", d.Id(), accountID))
+
+	if _, err := client.DeleteMTLSCertificate(ctx, cloudflare.AccountIdentifier(accountID), d.Id()); err != nil {
+		return diag.FromErr(fmt.Errorf("error deleting mTLS certificate %q: %w", d.Id(), err))
+	}
+
+	return nil
+}
+
+func resourceCloudflareMTLSCertificateImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	attributes := strings.SplitN(d.Id(), "/", 2)
+
+	if len(attributes) != 2 {
+		return nil, fmt.Errorf("invalid id (\"%s\") specified, should be in format \"accountID/certificateID\"", d.Id())
+	}
+
+	accountID, certificateID := attributes[0], attributes[1]
+
+	tflog.Debug(ctx, fmt.Sprintf("Importing Cloudflare mTLS certificate: id %s for account %s", certificateID, accountID))
+
+	d.Set(consts.AccountIDSchemaKey, accountID)
+	d.SetId(certificateID)
+
+	resourceCloudflareMTLSCertificateRead(ctx, d, meta)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// normalizeMTLSCertificatePEM re-encodes each PEM block in the certificates so
+// differences in line wrapping and surrounding whitespace are ignored. Content
+// which isn't PEM is only trimmed.
+func normalizeMTLSCertificatePEM(certificates string) string {
+	var out bytes.Buffer
+	rest := []byte(certificates)
+	for {
+		var block *pem.Block
+		block, rest = pem.Decode(rest)
+		if block == nil {
+			break
+		}
+		pem.Encode(&out, &pem.Block{Type: block.Type, Bytes: block.Bytes})
+	}
+
+	if out.Len() == 0 {
+		return strings.TrimSpace(certificates)
+	}
+
+	return out.String()
+}
+
+func suppressEquivalentMTLSCertificatePEM(k, old, new string, d *schema.ResourceData) bool {
+	return normalizeMTLSCertificatePEM(old) == normalizeMTLSCertificatePEM(new)
+}
@@ -0,0 +1,89 @@
+package sdkv2provider
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/cloudflare/terraform-provider-cloudflare/internal/consts"
+	cloudflare "github.com/curtislarson/cloudflare-go"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccCloudflareMTLSCertificate_Basic(t *testing.T) {
+	rnd := generateRandomResourceName()
+	name := fmt.Sprintf("cloudflare_mtls_certificate.%s", rnd)
+	accountID := os.Getenv("CLOUDFLARE_ACCOUNT_ID")
+	cert := os.Getenv("CLOUDFLARE_MUTUAL_TLS_CERTIFICATE")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAccount(t)
+		},
+		ProviderFactories: providerFactories,
+		CheckDestroy:      testAccCheckCloudflareMTLSCertificateDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareMTLSCertificateConfig(rnd, accountID, cert),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, consts.AccountIDSchemaKey, accountID),
+					resource.TestCheckResourceAttr(name, "name", rnd),
+					resource.TestCheckResourceAttr(name, "ca", "true"),
+					resource.TestCheckResourceAttrSet(name, "serial_number"),
+					resource.TestCheckResourceAttrSet(name, "expires_on"),
+				),
+			},
+			{
+				ResourceName:            name,
+				ImportState:             true,
+				ImportStateIdPrefix:     fmt.Sprintf("%s/", accountID),
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"certificates"},
+			},
+		},
+	})
+}
+
+func TestNormalizeMTLSCertificatePEM(t *testing.T) {
+	t.Parallel()
+
+	configured := "-----BEGIN CERTIFICATE-----\nMIIBszCCAVmgAwIBAgIUKLnS\nQNLfFy1lq5QK\n-----END CERTIFICATE-----\n"
+	returned := "\n-----BEGIN CERTIFICATE-----\r\nMIIBszCCAVmgAwIBAgIUKLnSQNLfFy1lq5QK\r\n-----END CERTIFICATE-----"
+	other := "-----BEGIN CERTIFICATE-----\nMIIBszCCAVmgAwIBAgIUKLnSQNLfFy1lq5QL\n-----END CERTIFICATE-----\n"
+
+	assert.Equal(t, normalizeMTLSCertificatePEM(configured), normalizeMTLSCertificatePEM(returned))
+	assert.NotEqual(t, normalizeMTLSCertificatePEM(configured), normalizeMTLSCertificatePEM(other))
+	assert.Equal(t, "not a certificate", normalizeMTLSCertificatePEM(" not a certificate\n"))
+}
+
+func testAccCheckCloudflareMTLSCertificateDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*cloudflare.API)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "cloudflare_mtls_certificate" {
+			continue
+		}
+
+		_, err := client.GetMTLSCertificate(context.Background(), cloudflare.AccountIdentifier(rs.Primary.Attributes[consts.AccountIDSchemaKey]), rs.Primary.ID)
+		if err == nil {
+			return fmt.Errorf("mTLS certificate still exists")
+		}
+	}
+
+	return nil
+}
+
+func testAccCloudflareMTLSCertificateConfig(rnd, accountID, cert string) string {
+	return fmt.Sprintf(`
+resource "cloudflare_mtls_certificate" "%[1]s" {
+	account_id   = "%[2]s"
+	name         = "%[1]s"
+	certificates = "%[3]s"
+	ca           = true
+}
+`, rnd, accountID, cert)
+}
@@ -2,6 +2,7 @@ package sdkv2provider
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"strings"
 
@@ -22,12 +23,43 @@ func resourceCloudflarePagesDomain() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: resourceCloudflarePagesDomainImport,
 		},
+		CustomizeDiff: resourceCloudflarePagesDomainCustomizeDiff,
 		Description: heredoc.Doc(`
 			Provides a resource for managing Cloudflare Pages domains.
 		`),
 	}
 }
 
+// resourceCloudflarePagesDomainCustomizeDiff checks that the Pages project
+// exists so a mistyped project name fails the plan rather than the apply.
+func resourceCloudflarePagesDomainCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if d.Id() != "" && !d.HasChange("project_name") && !d.HasChange(consts.AccountIDSchemaKey) {
+		return nil
+	}
+
+	// The project may be created in the same apply, in which case its name
+	// isn't known until then.
+	if !d.NewValueKnown("project_name") || !d.NewValueKnown(consts.AccountIDSchemaKey) {
+		return nil
+	}
+
+	client := meta.(*cloudflare.API)
+	accountID := d.Get(consts.AccountIDSchemaKey).(string)
+	projectName := d.Get("project_name").(string)
+
+	_, err := client.PagesProject(ctx, accountID, projectName)
+	if err != nil {
+		var notFoundError *cloudflare.NotFoundError
+		if errors.As(err, &notFoundError) {
+			return fmt.Errorf("pages project %q does not exist in account %q", projectName, accountID)
+		}
+
+		tflog.Warn(ctx, fmt.Sprintf("Unable to check Pages project %q exists in account %q: %s", projectName, accountID, err))
+	}
+
+	return nil
+}
+
 func resourceCloudflarePagesDomainCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*cloudflare.API)
 	accountID := d.Get(consts.AccountIDSchemaKey).(string)
@@ -3,6 +3,7 @@ package sdkv2provider
 import (
 	"fmt"
 	"os"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -48,3 +49,33 @@ func TestAccTestPagesDomain(t *testing.T) {
 		},
 	})
 }
+
+func TestAccTestPagesDomain_MissingProject(t *testing.T) {
+	rnd := generateRandomResourceName()
+	accountID := os.Getenv("CLOUDFLARE_ACCOUNT_ID")
+	domain := os.Getenv("CLOUDFLARE_DOMAIN")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAccount(t)
+		},
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testPagesDomainMissingProjectConfig(rnd, accountID, rnd+"."+domain),
+				ExpectError: regexp.MustCompile(fmt.Sprintf(`pages project "%s" does not exist in account "%s"`, rnd, accountID)),
+			},
+		},
+	})
+}
+
+func testPagesDomainMissingProjectConfig(resourceID, accountID, domain string) string {
+	return fmt.Sprintf(`
+		resource "cloudflare_pages_domain" "%[1]s" {
+		  account_id = "%[2]s"
+		  project_name = "%[1]s"
+		  domain = "%[3]s"
+		}
+		`, resourceID, accountID, domain)
+}
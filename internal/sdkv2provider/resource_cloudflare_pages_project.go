@@ -2,7 +2,9 @@ package sdkv2provider
 
 import (
 	"context"
+	"crypto/sha256"
 	"fmt"
+	"regexp"
 	"strings"
 	"time"
 
@@ -31,6 +33,57 @@ func resourceCloudflarePagesProject() *schema.Resource {
 	}
 }
 
+var pagesProjectSecretHashRegex = regexp.MustCompile(`^sha256:[0-9a-f]{64}$`)
+
+// pagesProjectSecretHash returns the value stored in state for a secret. The
+// API never returns secret values so only a hash is kept to detect changes
+// to the configuration without persisting the secret itself.
+func pagesProjectSecretHash(value string) string {
+	if pagesProjectSecretHashRegex.MatchString(value) {
+		return value
+	}
+
+	return fmt.Sprintf("sha256:%x", sha256.Sum256([]byte(value)))
+}
+
+func suppressPagesProjectSecretHash(k, old, new string, d *schema.ResourceData) bool {
+	if strings.HasSuffix(k, ".%") {
+		return false
+	}
+
+	return old != "" && old == pagesProjectSecretHash(new)
+}
+
+// addPagesProjectSecrets adds the secrets which were added or changed to the
+// environment variables of the deployment config. Unchanged secrets only have
+// their hash available and are left out so the API keeps their value, and
+// removed secrets are sent as null to delete them.
+func addPagesProjectSecrets(d *schema.ResourceData, key string, config *cloudflare.PagesProjectDeploymentConfigEnvironment) {
+	o, n := d.GetChange(key)
+	oldSecrets, _ := o.(map[string]interface{})
+	newSecrets, _ := n.(map[string]interface{})
+
+	if config.EnvVars == nil {
+		config.EnvVars = cloudflare.EnvironmentVariableMap{}
+	}
+
+	for name, value := range newSecrets {
+		if pagesProjectSecretHashRegex.MatchString(value.(string)) {
+			continue
+		}
+		config.EnvVars[name] = &cloudflare.EnvironmentVariable{
+			Value: value.(string),
+			Type:  cloudflare.SecretText,
+		}
+	}
+
+	for name := range oldSecrets {
+		if _, ok := newSecrets[name]; !ok {
+			config.EnvVars[name] = nil
+		}
+	}
+}
+
 func buildDeploymentConfig(environment interface{}) cloudflare.PagesProjectDeploymentConfigEnvironment {
 	config := cloudflare.PagesProjectDeploymentConfigEnvironment{}
 	parsed := environment.(map[string]interface{})
@@ -114,7 +167,7 @@ func buildDeploymentConfig(environment interface{}) cloudflare.PagesProjectDeplo
 	return config
 }
 
-func parseDeploymentConfig(deployment cloudflare.PagesProjectDeploymentConfigEnvironment) (returnValue []map[string]interface{}) {
+func parseDeploymentConfig(deployment cloudflare.PagesProjectDeploymentConfigEnvironment, secrets map[string]interface{}) (returnValue []map[string]interface{}) {
 	config := make(map[string]interface{})
 
 	config["compatibility_date"] = deployment.CompatibilityDate
@@ -132,6 +185,19 @@ func parseDeploymentConfig(deployment cloudflare.PagesProjectDeploymentConfigEnv
 	}
 	config["environment_variables"] = deploymentVars
 
+	deploymentVars = map[string]string{}
+	for key, value := range deployment.EnvVars {
+		if value.Type == cloudflare.SecretText {
+			// Secrets missing from state were set outside of Terraform and
+			// can't be hashed, so an empty value is stored to surface them.
+			deploymentVars[key] = ""
+			if secret, ok := secrets[key]; ok {
+				deploymentVars[key] = pagesProjectSecretHash(secret.(string))
+			}
+		}
+	}
+	config["secrets"] = deploymentVars
+
 	deploymentVars = map[string]string{}
 	for key, value := range deployment.KvNamespaces {
 		deploymentVars[key] = value.Value
@@ -243,9 +309,11 @@ func buildPagesProject(d *schema.ResourceData) cloudflare.PagesProject {
 
 	if previewConfig, ok := d.GetOk("deployment_configs.0.preview.0"); ok {
 		project.DeploymentConfigs.Preview = buildDeploymentConfig(previewConfig)
+		addPagesProjectSecrets(d, "deployment_configs.0.preview.0.secrets", &project.DeploymentConfigs.Preview)
 	}
 	if productionConfig, ok := d.GetOk("deployment_configs.0.production.0"); ok {
 		project.DeploymentConfigs.Production = buildDeploymentConfig(productionConfig)
+		addPagesProjectSecrets(d, "deployment_configs.0.production.0.secrets", &project.DeploymentConfigs.Production)
 	}
 
 	return project
@@ -304,8 +372,10 @@ func resourceCloudflarePagesProjectRead(ctx context.Context, d *schema.ResourceD
 
 	var deploymentConfigs []map[string]interface{}
 	deploymentConfig := make(map[string]interface{})
-	deploymentConfig["preview"] = parseDeploymentConfig(project.DeploymentConfigs.Preview)
-	deploymentConfig["production"] = parseDeploymentConfig(project.DeploymentConfigs.Production)
+	previewSecrets, _ := d.Get("deployment_configs.0.preview.0.secrets").(map[string]interface{})
+	productionSecrets, _ := d.Get("deployment_configs.0.production.0.secrets").(map[string]interface{})
+	deploymentConfig["preview"] = parseDeploymentConfig(project.DeploymentConfigs.Preview, previewSecrets)
+	deploymentConfig["production"] = parseDeploymentConfig(project.DeploymentConfigs.Production, productionSecrets)
 	deploymentConfigs = append(deploymentConfigs, deploymentConfig)
 	d.Set("deployment_configs", deploymentConfigs)
 
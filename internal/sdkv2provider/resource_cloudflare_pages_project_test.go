@@ -6,6 +6,7 @@ import (
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/stretchr/testify/assert"
 )
 
 const testPagesProjectEmptyDeploymentConfig = `
@@ -79,6 +80,9 @@ func testPagesProjectDeploymentConfig(resourceID, accountID, projectName string)
 				environment_variables = {
 					ENVIRONMENT = "preview"
 				}
+				secrets = {
+					TURNSTILE_SECRET = "1x0000000000000000000000000000000AA"
+				}
 				kv_namespaces = {
 					KV_BINDING = "5eb63bbbe01eeed093cb22bb8f5acdc3"
 				}
@@ -107,6 +111,10 @@ func testPagesProjectDeploymentConfig(resourceID, accountID, projectName string)
 					ENVIRONMENT = "production"
 					OTHER_VALUE = "other value"
 				}
+				secrets = {
+					TURNSTILE_SECRET = "1x0000000000000000000000000000000AA"
+					TURNSTILE_INVIS_SECRET = "2x0000000000000000000000000000000AA"
+				}
 				kv_namespaces = {
 					KV_BINDING_1 = "5eb63bbbe01eeed093cb22bb8f5acdc3"
 					KV_BINDING_2 = "3cdca5f8bb22bc390deee10ebbb36be5"
@@ -250,6 +258,9 @@ func TestAccCloudflarePagesProject_DeploymentConfig(t *testing.T) {
 					resource.TestCheckResourceAttr(name, "deployment_configs.0.preview.0.environment_variables.%", "1"),
 					resource.TestCheckResourceAttr(name, "deployment_configs.0.preview.0.environment_variables.ENVIRONMENT", "preview"),
 
+					resource.TestCheckResourceAttr(name, "deployment_configs.0.preview.0.secrets.%", "1"),
+					resource.TestCheckResourceAttr(name, "deployment_configs.0.preview.0.secrets.TURNSTILE_SECRET", pagesProjectSecretHash("1x0000000000000000000000000000000AA")),
+
 					resource.TestCheckResourceAttr(name, "deployment_configs.0.preview.0.kv_namespaces.%", "1"),
 					resource.TestCheckResourceAttr(name, "deployment_configs.0.preview.0.kv_namespaces.KV_BINDING", "5eb63bbbe01eeed093cb22bb8f5acdc3"),
 
@@ -270,6 +281,10 @@ func TestAccCloudflarePagesProject_DeploymentConfig(t *testing.T) {
 					resource.TestCheckResourceAttr(name, "deployment_configs.0.production.0.environment_variables.ENVIRONMENT", "production"),
 					resource.TestCheckResourceAttr(name, "deployment_configs.0.production.0.environment_variables.OTHER_VALUE", "other value"),
 
+					resource.TestCheckResourceAttr(name, "deployment_configs.0.production.0.secrets.%", "2"),
+					resource.TestCheckResourceAttr(name, "deployment_configs.0.production.0.secrets.TURNSTILE_SECRET", pagesProjectSecretHash("1x0000000000000000000000000000000AA")),
+					resource.TestCheckResourceAttr(name, "deployment_configs.0.production.0.secrets.TURNSTILE_INVIS_SECRET", pagesProjectSecretHash("2x0000000000000000000000000000000AA")),
+
 					resource.TestCheckResourceAttr(name, "deployment_configs.0.production.0.kv_namespaces.%", "2"),
 					resource.TestCheckResourceAttr(name, "deployment_configs.0.production.0.kv_namespaces.KV_BINDING_1", "5eb63bbbe01eeed093cb22bb8f5acdc3"),
 					resource.TestCheckResourceAttr(name, "deployment_configs.0.production.0.kv_namespaces.KV_BINDING_2", "3cdca5f8bb22bc390deee10ebbb36be5"),
@@ -299,6 +314,18 @@ func TestAccCloudflarePagesProject_DeploymentConfig(t *testing.T) {
 	})
 }
 
+func TestPagesProjectSecretHash(t *testing.T) {
+	hash := pagesProjectSecretHash("secret")
+
+	assert.Equal(t, "sha256:2bb80d537b1da3e38bd30361aa855686bde0eacd7162fef6a25fe97bf527a25b", hash)
+	assert.Equal(t, hash, pagesProjectSecretHash(hash))
+
+	key := "deployment_configs.0.production.0.secrets.MY_SECRET"
+	assert.True(t, suppressPagesProjectSecretHash(key, hash, "secret", nil))
+	assert.False(t, suppressPagesProjectSecretHash(key, hash, "changed", nil))
+	assert.False(t, suppressPagesProjectSecretHash(key, "", "secret", nil))
+}
+
 func TestAccCloudflarePagesProject_DirectUpload(t *testing.T) {
 	t.Skip("Skipping Pages acceptance tests pending investigation into automating the setup and teardown")
 
@@ -0,0 +1,242 @@
+package sdkv2provider
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/cloudflare/terraform-provider-cloudflare/internal/consts"
+	cloudflare "github.com/curtislarson/cloudflare-go"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// r2BucketEventNotificationConfig is the event notification configuration of
+// an R2 bucket.
+type r2BucketEventNotificationConfig struct {
+	BucketName string                           `json:"bucketName"`
+	Queues     []r2BucketEventNotificationQueue `json:"queues"`
+}
+
+type r2BucketEventNotificationQueue struct {
+	QueueID   string                          `json:"queueId"`
+	QueueName string                          `json:"queueName"`
+	Rules     []r2BucketEventNotificationRule `json:"rules"`
+}
+
+type r2BucketEventNotificationRule struct {
+	Actions     []string `json:"actions"`
+	Prefix      string   `json:"prefix,omitempty"`
+	Suffix      string   `json:"suffix,omitempty"`
+	Description string   `json:"description,omitempty"`
+}
+
+func resourceCloudflareR2BucketEventNotification() *schema.Resource {
+	return &schema.Resource{
+		Schema:        resourceCloudflareR2BucketEventNotificationSchema(),
+		CreateContext: resourceCloudflareR2BucketEventNotificationCreate,
+		ReadContext:   resourceCloudflareR2BucketEventNotificationRead,
+		UpdateContext: resourceCloudflareR2BucketEventNotificationUpdate,
+		DeleteContext: resourceCloudflareR2BucketEventNotificationDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceCloudflareR2BucketEventNotificationImport,
+		},
+		Description: heredoc.Doc(`
+			Provides a resource to send notifications of changes to the
+			objects in an R2 bucket to a queue. Each resource manages all of
+			the rules between one bucket and one queue.
+		`),
+	}
+}
+
+func resourceCloudflareR2BucketEventNotificationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	accountID := d.Get(consts.AccountIDSchemaKey).(string)
+	bucketName := d.Get("bucket_name").(string)
+	queueID := d.Get("queue_id").(string)
+
+	tflog.Debug(ctx, fmt.Sprintf("Creating Cloudflare R2 event notifications from bucket %q to queue %q", bucketName, queueID))
+
+	if err := putR2BucketEventNotificationRules(ctx, client, accountID, bucketName, queueID, d.Get("rule").([]interface{})); err != nil {
+		return diag.FromErr(fmt.Errorf("error creating R2 event notifications from bucket %q to queue %q: %w", bucketName, queueID, err))
+	}
+
+	d.SetId(queueID)
+
+	return resourceCloudflareR2BucketEventNotificationRead(ctx, d, meta)
+}
+
+func resourceCloudflareR2BucketEventNotificationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	accountID := d.Get(consts.AccountIDSchemaKey).(string)
+	bucketName := d.Get("bucket_name").(string)
+
+	res, err := client.Raw(ctx, http.MethodGet, r2BucketEventNotificationPath(accountID, bucketName), nil, nil)
+	if err != nil {
+		var notFoundError *cloudflare.NotFoundError
+		if errors.As(err, &notFoundError) {
+			tflog.Info(ctx, fmt.Sprintf("R2 event notifications for bucket %q not found", bucketName))
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("error reading R2 event notifications for bucket %q: %w", bucketName, err))
+	}
+
+	var config r2BucketEventNotificationConfig
+	if err := json.Unmarshal(res, &config); err != nil {
+		return diag.FromErr(fmt.Errorf("failed to unmarshal R2 event notifications response: %w", err))
+	}
+
+	for _, queue := range config.Queues {
+		if queue.QueueID != d.Id() {
+			continue
+		}
+
+		d.Set("queue_id", queue.QueueID)
+		d.Set("queue_name", queue.QueueName)
+		d.Set("rule", flattenR2BucketEventNotificationRules(queue.Rules))
+
+		return nil
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("R2 event notifications from bucket %q to queue %q not found", bucketName, d.Id()))
+	d.SetId("")
+
+	return nil
+}
+
+func resourceCloudflareR2BucketEventNotificationUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	accountID := d.Get(consts.AccountIDSchemaKey).(string)
+	bucketName := d.Get("bucket_name").(string)
+	queueID := d.Id()
+
+	tflog.Debug(ctx, fmt.Sprintf("Updating Cloudflare R2 event notifications from bucket %q to queue %q", bucketName, queueID))
+
+	oldRules, newRules := d.GetChange("rule")
+	restored, err := replaceR2BucketEventNotificationRules(ctx, client, accountID, bucketName, queueID, oldRules.([]interface{}), newRules.([]interface{}))
+	if err != nil {
+		if restored {
+			// Keep the previous rules in state as they're still applied.
+			d.Partial(true)
+		} else {
+			// The previous rules are gone and the new ones weren't applied,
+			// so the resource is recreated by the next apply.
+			d.SetId("")
+		}
+		return diag.FromErr(fmt.Errorf("error updating R2 event notifications from bucket %q to queue %q: %w", bucketName, queueID, err))
+	}
+
+	return resourceCloudflareR2BucketEventNotificationRead(ctx, d, meta)
+}
+
+func resourceCloudflareR2BucketEventNotificationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	accountID := d.Get(consts.AccountIDSchemaKey).(string)
+	bucketName := d.Get("bucket_name").(string)
+
+	tflog.Debug(ctx, fmt.Sprintf("Deleting Cloudflare R2 event notifications from bucket %q to queue %q", bucketName, d.Id()))
+
+	_, err := client.Raw(ctx, http.MethodDelete, r2BucketEventNotificationQueuePath(accountID, bucketName, d.Id()), nil, nil)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error deleting R2 event notifications from bucket %q to queue %q: %w", bucketName, d.Id(), err))
+	}
+
+	return nil
+}
+
+func resourceCloudflareR2BucketEventNotificationImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	idAttr := strings.SplitN(d.Id(), "/", 3)
+
+	if len(idAttr) != 3 {
+		return nil, fmt.Errorf("invalid id (\"%s\") specified, should be in format \"accountID/bucketName/queueID\"", d.Id())
+	}
+
+	accountID, bucketName, queueID := idAttr[0], idAttr[1], idAttr[2]
+
+	tflog.Debug(ctx, fmt.Sprintf("Importing Cloudflare R2 event notifications: queue %s for bucket %s in account %s", queueID, bucketName, accountID))
+
+	d.Set(consts.AccountIDSchemaKey, accountID)
+	d.Set("bucket_name", bucketName)
+	d.SetId(queueID)
+
+	resourceCloudflareR2BucketEventNotificationRead(ctx, d, meta)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// replaceR2BucketEventNotificationRules replaces the rules of the queue. Rules
+// are added to those already configured for the queue, so the existing ones
+// are removed first. When the new rules can't be added the old ones are put
+// back, and restored reports whether the old rules are still applied.
+func replaceR2BucketEventNotificationRules(ctx context.Context, client *cloudflare.API, accountID, bucketName, queueID string, oldRules, newRules []interface{}) (restored bool, err error) {
+	_, err = client.Raw(ctx, http.MethodDelete, r2BucketEventNotificationQueuePath(accountID, bucketName, queueID), nil, nil)
+	if err != nil {
+		return true, fmt.Errorf("error removing existing rules: %w", err)
+	}
+
+	err = putR2BucketEventNotificationRules(ctx, client, accountID, bucketName, queueID, newRules)
+	if err == nil {
+		return false, nil
+	}
+
+	tflog.Warn(ctx, fmt.Sprintf("Restoring previous R2 event notification rules from bucket %q to queue %q: %s", bucketName, queueID, err))
+
+	if restoreErr := putR2BucketEventNotificationRules(ctx, client, accountID, bucketName, queueID, oldRules); restoreErr != nil {
+		return false, fmt.Errorf("%w (restoring the previous rules also failed: %s)", err, restoreErr)
+	}
+
+	return true, err
+}
+
+func putR2BucketEventNotificationRules(ctx context.Context, client *cloudflare.API, accountID, bucketName, queueID string, rules []interface{}) error {
+	body := struct {
+		Rules []r2BucketEventNotificationRule `json:"rules"`
+	}{Rules: expandR2BucketEventNotificationRules(rules)}
+
+	_, err := client.Raw(ctx, http.MethodPut, r2BucketEventNotificationQueuePath(accountID, bucketName, queueID), body, nil)
+
+	return err
+}
+
+func expandR2BucketEventNotificationRules(rules []interface{}) []r2BucketEventNotificationRule {
+	result := make([]r2BucketEventNotificationRule, 0, len(rules))
+	for _, r := range rules {
+		rule := r.(map[string]interface{})
+		result = append(result, r2BucketEventNotificationRule{
+			Actions:     expandInterfaceToStringList(rule["actions"].(*schema.Set).List()),
+			Prefix:      rule["prefix"].(string),
+			Suffix:      rule["suffix"].(string),
+			Description: rule["description"].(string),
+		})
+	}
+
+	return result
+}
+
+func flattenR2BucketEventNotificationRules(rules []r2BucketEventNotificationRule) []interface{} {
+	result := make([]interface{}, 0, len(rules))
+	for _, rule := range rules {
+		result = append(result, map[string]interface{}{
+			"actions":     rule.Actions,
+			"prefix":      rule.Prefix,
+			"suffix":      rule.Suffix,
+			"description": rule.Description,
+		})
+	}
+
+	return result
+}
+
+func r2BucketEventNotificationPath(accountID, bucketName string) string {
+	return fmt.Sprintf("/accounts/%s/event_notifications/r2/%s/configuration", accountID, bucketName)
+}
+
+func r2BucketEventNotificationQueuePath(accountID, bucketName, queueID string) string {
+	return fmt.Sprintf("%s/queues/%s", r2BucketEventNotificationPath(accountID, bucketName), queueID)
+}
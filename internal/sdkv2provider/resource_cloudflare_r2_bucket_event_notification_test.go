@@ -0,0 +1,177 @@
+package sdkv2provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"regexp"
+	"testing"
+
+	cloudflare "github.com/curtislarson/cloudflare-go"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccCloudflareR2BucketEventNotification_Basic(t *testing.T) {
+	rnd := generateRandomResourceName()
+	name := "cloudflare_r2_bucket_event_notification." + rnd
+	accountID := os.Getenv("CLOUDFLARE_ACCOUNT_ID")
+	bucketName := os.Getenv("CLOUDFLARE_R2_BUCKET_NAME")
+	queueID := os.Getenv("CLOUDFLARE_QUEUE_ID")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckR2Bucket(t)
+			if queueID == "" {
+				t.Skip("Skipping acceptance test as CLOUDFLARE_QUEUE_ID is not set")
+			}
+		},
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareR2BucketEventNotificationConfig(rnd, accountID, bucketName, queueID, "PutObject", "images/"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "account_id", accountID),
+					resource.TestCheckResourceAttr(name, "bucket_name", bucketName),
+					resource.TestCheckResourceAttr(name, "queue_id", queueID),
+					resource.TestCheckResourceAttrSet(name, "queue_name"),
+					resource.TestCheckResourceAttr(name, "rule.#", "1"),
+					resource.TestCheckResourceAttr(name, "rule.0.actions.#", "1"),
+					resource.TestCheckResourceAttr(name, "rule.0.prefix", "images/"),
+					resource.TestCheckResourceAttr(name, "rule.0.suffix", ".png"),
+				),
+			},
+			{
+				Config: testAccCloudflareR2BucketEventNotificationConfig(rnd, accountID, bucketName, queueID, "DeleteObject", "thumbnails/"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "rule.#", "1"),
+					resource.TestCheckResourceAttr(name, "rule.0.prefix", "thumbnails/"),
+				),
+			},
+			{
+				ResourceName:        name,
+				ImportState:         true,
+				ImportStateIdPrefix: fmt.Sprintf("%s/%s/", accountID, bucketName),
+				ImportStateVerify:   true,
+			},
+		},
+	})
+}
+
+func TestResourceCloudflareR2BucketEventNotificationValidate(t *testing.T) {
+	r := resourceCloudflareR2BucketEventNotification()
+
+	diags := r.Validate(terraform.NewResourceConfigRaw(map[string]interface{}{
+		"account_id":  "account",
+		"bucket_name": "bucket",
+		"queue_id":    "queue",
+		"rule":        []interface{}{map[string]interface{}{"actions": []interface{}{"GetObject"}}},
+	}))
+	if assert.True(t, diags.HasError()) {
+		assert.Regexp(t, regexp.MustCompile(`expected rule\.0\.actions\.\d+ to be one of`), diags[0].Summary)
+	}
+
+	diags = r.Validate(terraform.NewResourceConfigRaw(map[string]interface{}{
+		"account_id":  "account",
+		"bucket_name": "bucket",
+		"rule":        []interface{}{map[string]interface{}{"actions": []interface{}{"PutObject"}}},
+	}))
+	if assert.True(t, diags.HasError()) {
+		assert.Equal(t, "Missing required argument", diags[0].Summary)
+		assert.Contains(t, diags[0].Detail, `"queue_id" is required`)
+	}
+}
+
+func TestReplaceR2BucketEventNotificationRules(t *testing.T) {
+	rule := func(prefix string) []interface{} {
+		return []interface{}{map[string]interface{}{
+			"actions":     schema.NewSet(schema.HashString, []interface{}{"PutObject"}),
+			"prefix":      prefix,
+			"suffix":      "",
+			"description": "",
+		}}
+	}
+
+	testCases := map[string]struct {
+		failedPuts       int
+		expectedRestored bool
+		expectedErr      bool
+		expectedRequests []string
+	}{
+		"replaced": {
+			expectedRequests: []string{"DELETE", "PUT new/"},
+		},
+		"restored": {
+			failedPuts:       1,
+			expectedRestored: true,
+			expectedErr:      true,
+			expectedRequests: []string{"DELETE", "PUT new/", "PUT old/"},
+		},
+		"not restored": {
+			failedPuts:       2,
+			expectedErr:      true,
+			expectedRequests: []string{"DELETE", "PUT new/", "PUT old/"},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			var requests []string
+			failedPuts := tc.failedPuts
+
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				request := r.Method
+				if r.Method == http.MethodPut {
+					var body struct {
+						Rules []r2BucketEventNotificationRule `json:"rules"`
+					}
+					json.NewDecoder(r.Body).Decode(&body)
+					request += " " + body.Rules[0].Prefix
+				}
+				requests = append(requests, request)
+
+				w.Header().Set("Content-Type", "application/json")
+				if r.Method == http.MethodPut && failedPuts > 0 {
+					failedPuts--
+					w.WriteHeader(http.StatusBadRequest)
+					fmt.Fprint(w, `{"success": false, "errors": [{"code": 10000, "message": "invalid rule"}], "messages": [], "result": null}`)
+					return
+				}
+				fmt.Fprint(w, `{"success": true, "errors": [], "messages": [], "result": null}`)
+			}))
+			defer server.Close()
+
+			config := Config{APIToken: "token", Options: []cloudflare.Option{cloudflare.BaseURL(server.URL), cloudflare.UsingRateLimit(1000)}}
+			client, err := config.Client(context.Background())
+			assert.NoError(t, err)
+
+			restored, err := replaceR2BucketEventNotificationRules(context.Background(), client, "account", "bucket", "queue", rule("old/"), rule("new/"))
+			assert.Equal(t, tc.expectedRestored, restored)
+			assert.Equal(t, tc.expectedErr, err != nil)
+			assert.Equal(t, tc.expectedRequests, requests)
+		})
+	}
+}
+
+func testAccCloudflareR2BucketEventNotificationConfig(rnd, accountID, bucketName, queueID, action, prefix string) string {
+	return fmt.Sprintf(`
+resource "cloudflare_r2_bucket_event_notification" "%[1]s" {
+	account_id  = "%[2]s"
+	bucket_name = "%[3]s"
+	queue_id    = "%[4]s"
+
+	rule {
+		actions     = ["%[5]s"]
+		prefix      = "%[6]s"
+		suffix      = ".png"
+		description = "Terraform acceptance test rule"
+	}
+}
+`, rnd, accountID, bucketName, queueID, action, prefix)
+}
@@ -0,0 +1,227 @@
+package sdkv2provider
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/cloudflare/terraform-provider-cloudflare/internal/consts"
+	cloudflare "github.com/curtislarson/cloudflare-go"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// r2BucketLock is the lock configuration of an R2 bucket.
+type r2BucketLock struct {
+	Rules []r2BucketLockRule `json:"rules"`
+}
+
+type r2BucketLockRule struct {
+	ID        string                `json:"id"`
+	Enabled   bool                  `json:"enabled"`
+	Prefix    string                `json:"prefix,omitempty"`
+	Condition r2BucketLockCondition `json:"condition"`
+}
+
+type r2BucketLockCondition struct {
+	Type          string `json:"type"`
+	MaxAgeSeconds int    `json:"maxAgeSeconds,omitempty"`
+	Date          string `json:"date,omitempty"`
+}
+
+func resourceCloudflareR2BucketLock() *schema.Resource {
+	return &schema.Resource{
+		Schema:        resourceCloudflareR2BucketLockSchema(),
+		CreateContext: resourceCloudflareR2BucketLockCreate,
+		ReadContext:   resourceCloudflareR2BucketLockRead,
+		UpdateContext: resourceCloudflareR2BucketLockUpdate,
+		DeleteContext: resourceCloudflareR2BucketLockDelete,
+		CustomizeDiff: resourceCloudflareR2BucketLockCustomizeDiff,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceCloudflareR2BucketLockImport,
+		},
+		Description: heredoc.Doc(`
+			Provides a resource to manage the lock rules of an R2 bucket.
+			Objects matching a rule can't be deleted or overwritten until
+			their retention period has passed.
+		`),
+	}
+}
+
+func resourceCloudflareR2BucketLockCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	accountID := d.Get(consts.AccountIDSchemaKey).(string)
+	bucketName := d.Get("bucket_name").(string)
+
+	tflog.Debug(ctx, fmt.Sprintf("Creating Cloudflare R2 bucket lock for bucket %q", bucketName))
+
+	lock := r2BucketLock{Rules: expandR2BucketLockRules(d.Get("rules").([]interface{}))}
+	if _, err := client.Raw(ctx, http.MethodPut, r2BucketLockPath(accountID, bucketName), lock, nil); err != nil {
+		return diag.FromErr(fmt.Errorf("error creating R2 bucket lock for bucket %q: %w", bucketName, err))
+	}
+
+	d.SetId(bucketName)
+
+	return resourceCloudflareR2BucketLockRead(ctx, d, meta)
+}
+
+func resourceCloudflareR2BucketLockRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	accountID := d.Get(consts.AccountIDSchemaKey).(string)
+
+	res, err := client.Raw(ctx, http.MethodGet, r2BucketLockPath(accountID, d.Id()), nil, nil)
+	if err != nil {
+		var notFoundError *cloudflare.NotFoundError
+		if errors.As(err, &notFoundError) {
+			tflog.Info(ctx, fmt.Sprintf("R2 bucket %q not found", d.Id()))
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("error reading R2 bucket lock for bucket %q: %w", d.Id(), err))
+	}
+
+	var lock r2BucketLock
+	if err := json.Unmarshal(res, &lock); err != nil {
+		return diag.FromErr(fmt.Errorf("failed to unmarshal R2 bucket lock response: %w", err))
+	}
+
+	if len(lock.Rules) == 0 {
+		tflog.Info(ctx, fmt.Sprintf("R2 bucket %q has no lock rules", d.Id()))
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("bucket_name", d.Id())
+	d.Set("rules", flattenR2BucketLockRules(lock.Rules))
+
+	return nil
+}
+
+func resourceCloudflareR2BucketLockUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	accountID := d.Get(consts.AccountIDSchemaKey).(string)
+
+	tflog.Debug(ctx, fmt.Sprintf("Updating Cloudflare R2 bucket lock for bucket %q", d.Id()))
+
+	lock := r2BucketLock{Rules: expandR2BucketLockRules(d.Get("rules").([]interface{}))}
+	if _, err := client.Raw(ctx, http.MethodPut, r2BucketLockPath(accountID, d.Id()), lock, nil); err != nil {
+		return diag.FromErr(fmt.Errorf("error updating R2 bucket lock for bucket %q: %w", d.Id(), err))
+	}
+
+	return resourceCloudflareR2BucketLockRead(ctx, d, meta)
+}
+
+func resourceCloudflareR2BucketLockDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	accountID := d.Get(consts.AccountIDSchemaKey).(string)
+
+	tflog.Debug(ctx, fmt.Sprintf("Deleting Cloudflare R2 bucket lock for bucket %q", d.Id()))
+
+	lock := r2BucketLock{Rules: []r2BucketLockRule{}}
+	if _, err := client.Raw(ctx, http.MethodPut, r2BucketLockPath(accountID, d.Id()), lock, nil); err != nil {
+		return diag.FromErr(fmt.Errorf("error deleting R2 bucket lock for bucket %q: %w", d.Id(), err))
+	}
+
+	return nil
+}
+
+func resourceCloudflareR2BucketLockImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	idAttr := strings.SplitN(d.Id(), "/", 2)
+
+	if len(idAttr) != 2 {
+		return nil, fmt.Errorf("invalid id (\"%s\") specified, should be in format \"accountID/bucketName\"", d.Id())
+	}
+
+	accountID, bucketName := idAttr[0], idAttr[1]
+
+	tflog.Debug(ctx, fmt.Sprintf("Importing Cloudflare R2 bucket lock: bucket %s in account %s", bucketName, accountID))
+
+	d.Set(consts.AccountIDSchemaKey, accountID)
+	d.SetId(bucketName)
+
+	resourceCloudflareR2BucketLockRead(ctx, d, meta)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// resourceCloudflareR2BucketLockCustomizeDiff validates the fields of each
+// rule condition against its type. Nested fields can't use ConflictsWith so
+// this is done at plan time instead.
+func resourceCloudflareR2BucketLockCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	for i, r := range d.Get("rules").([]interface{}) {
+		rule, ok := r.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		for j, c := range rule["condition"].([]interface{}) {
+			condition, ok := c.(map[string]interface{})
+			if !ok {
+				continue
+			}
+
+			prefix := fmt.Sprintf("rules.%d.condition.%d", i, j)
+			if !d.NewValueKnown(prefix+".max_age_seconds") || !d.NewValueKnown(prefix+".date") {
+				continue
+			}
+
+			if err := validateR2BucketLockCondition(condition); err != nil {
+				return fmt.Errorf("rules.%d.condition: %w", i, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+func expandR2BucketLockRules(rules []interface{}) []r2BucketLockRule {
+	result := make([]r2BucketLockRule, 0, len(rules))
+	for _, r := range rules {
+		rule := r.(map[string]interface{})
+		lockRule := r2BucketLockRule{
+			ID:      rule["id"].(string),
+			Enabled: rule["enabled"].(bool),
+			Prefix:  rule["prefix"].(string),
+		}
+
+		if conditions := rule["condition"].([]interface{}); len(conditions) > 0 && conditions[0] != nil {
+			condition := conditions[0].(map[string]interface{})
+			lockRule.Condition = r2BucketLockCondition{
+				Type:          condition["type"].(string),
+				MaxAgeSeconds: condition["max_age_seconds"].(int),
+				Date:          condition["date"].(string),
+			}
+		}
+
+		result = append(result, lockRule)
+	}
+
+	return result
+}
+
+func flattenR2BucketLockRules(rules []r2BucketLockRule) []interface{} {
+	result := make([]interface{}, 0, len(rules))
+	for _, rule := range rules {
+		result = append(result, map[string]interface{}{
+			"id":      rule.ID,
+			"enabled": rule.Enabled,
+			"prefix":  rule.Prefix,
+			"condition": []interface{}{map[string]interface{}{
+				"type":            rule.Condition.Type,
+				"max_age_seconds": rule.Condition.MaxAgeSeconds,
+				"date":            rule.Condition.Date,
+			}},
+		})
+	}
+
+	return result
+}
+
+func r2BucketLockPath(accountID, bucketName string) string {
+	return fmt.Sprintf("/accounts/%s/r2/buckets/%s/lock", accountID, bucketName)
+}
@@ -0,0 +1,123 @@
+package sdkv2provider
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccCloudflareR2BucketLock_Basic(t *testing.T) {
+	rnd := generateRandomResourceName()
+	name := "cloudflare_r2_bucket_lock." + rnd
+	accountID := os.Getenv("CLOUDFLARE_ACCOUNT_ID")
+	bucketName := os.Getenv("CLOUDFLARE_R2_BUCKET_NAME")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckR2Bucket(t)
+		},
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareR2BucketLockAgeConfig(rnd, accountID, bucketName, 86400),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "account_id", accountID),
+					resource.TestCheckResourceAttr(name, "bucket_name", bucketName),
+					resource.TestCheckResourceAttr(name, "rules.#", "1"),
+					resource.TestCheckResourceAttr(name, "rules.0.id", "logs"),
+					resource.TestCheckResourceAttr(name, "rules.0.enabled", "true"),
+					resource.TestCheckResourceAttr(name, "rules.0.prefix", "logs/"),
+					resource.TestCheckResourceAttr(name, "rules.0.condition.0.type", "Age"),
+					resource.TestCheckResourceAttr(name, "rules.0.condition.0.max_age_seconds", "86400"),
+				),
+			},
+			{
+				Config: testAccCloudflareR2BucketLockAgeConfig(rnd, accountID, bucketName, 172800),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "rules.0.condition.0.max_age_seconds", "172800"),
+				),
+			},
+			{
+				ResourceName:        name,
+				ImportState:         true,
+				ImportStateIdPrefix: fmt.Sprintf("%s/", accountID),
+				ImportStateVerify:   true,
+			},
+		},
+	})
+}
+
+func TestAccCloudflareR2BucketLock_ConflictingCondition(t *testing.T) {
+	rnd := generateRandomResourceName()
+	accountID := os.Getenv("CLOUDFLARE_ACCOUNT_ID")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAccount(t)
+		},
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+resource "cloudflare_r2_bucket_lock" "%[1]s" {
+	account_id  = "%[2]s"
+	bucket_name = "%[1]s"
+
+	rules {
+		id = "logs"
+
+		condition {
+			type            = "Date"
+			date            = "2030-01-01T00:00:00Z"
+			max_age_seconds = 86400
+		}
+	}
+}
+`, rnd, accountID),
+				ExpectError: regexp.MustCompile(`max_age_seconds cannot be set when type is "Date"`),
+			},
+		},
+	})
+}
+
+func TestValidateR2BucketLockCondition(t *testing.T) {
+	condition := func(conditionType string, maxAge int, date string) map[string]interface{} {
+		return map[string]interface{}{
+			"type":            conditionType,
+			"max_age_seconds": maxAge,
+			"date":            date,
+		}
+	}
+
+	assert.NoError(t, validateR2BucketLockCondition(condition("Age", 86400, "")))
+	assert.NoError(t, validateR2BucketLockCondition(condition("Date", 0, "2030-01-01T00:00:00Z")))
+	assert.EqualError(t, validateR2BucketLockCondition(condition("Age", 0, "")), `max_age_seconds must be set when type is "Age"`)
+	assert.EqualError(t, validateR2BucketLockCondition(condition("Age", 86400, "2030-01-01T00:00:00Z")), `date cannot be set when type is "Age"`)
+	assert.EqualError(t, validateR2BucketLockCondition(condition("Date", 0, "")), `date must be set when type is "Date"`)
+	assert.EqualError(t, validateR2BucketLockCondition(condition("Date", 86400, "2030-01-01T00:00:00Z")), `max_age_seconds cannot be set when type is "Date"`)
+}
+
+func testAccCloudflareR2BucketLockAgeConfig(rnd, accountID, bucketName string, maxAge int) string {
+	return fmt.Sprintf(`
+resource "cloudflare_r2_bucket_lock" "%[1]s" {
+	account_id  = "%[2]s"
+	bucket_name = "%[3]s"
+
+	rules {
+		id     = "logs"
+		prefix = "logs/"
+
+		condition {
+			type            = "Age"
+			max_age_seconds = %[4]d
+		}
+	}
+}
+`, rnd, accountID, bucketName, maxAge)
+}
@@ -0,0 +1,163 @@
+package sdkv2provider
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/cloudflare/terraform-provider-cloudflare/internal/consts"
+	cloudflare "github.com/curtislarson/cloudflare-go"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// r2CustomDomain is a custom domain connected to an R2 bucket.
+type r2CustomDomain struct {
+	Domain  string                `json:"domain,omitempty"`
+	ZoneID  string                `json:"zoneId,omitempty"`
+	Enabled bool                  `json:"enabled"`
+	MinTLS  string                `json:"minTLS,omitempty"`
+	Status  *r2CustomDomainStatus `json:"status,omitempty"`
+}
+
+type r2CustomDomainStatus struct {
+	Ownership string `json:"ownership"`
+	SSL       string `json:"ssl"`
+}
+
+func resourceCloudflareR2CustomDomain() *schema.Resource {
+	return &schema.Resource{
+		Schema:        resourceCloudflareR2CustomDomainSchema(),
+		CreateContext: resourceCloudflareR2CustomDomainCreate,
+		ReadContext:   resourceCloudflareR2CustomDomainRead,
+		UpdateContext: resourceCloudflareR2CustomDomainUpdate,
+		DeleteContext: resourceCloudflareR2CustomDomainDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceCloudflareR2CustomDomainImport,
+		},
+		Description: heredoc.Doc(`
+			Provides a resource to connect a custom domain to an R2 bucket so
+			the objects in the bucket are served publicly over the domain.
+		`),
+	}
+}
+
+func resourceCloudflareR2CustomDomainCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	accountID := d.Get(consts.AccountIDSchemaKey).(string)
+	bucketName := d.Get("bucket_name").(string)
+	domain := r2CustomDomain{
+		Domain:  d.Get("domain").(string),
+		ZoneID:  d.Get(consts.ZoneIDSchemaKey).(string),
+		Enabled: d.Get("enabled").(bool),
+		MinTLS:  d.Get("min_tls").(string),
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Creating Cloudflare R2 custom domain %q for bucket %q", domain.Domain, bucketName))
+
+	_, err := client.Raw(ctx, http.MethodPost, r2CustomDomainsPath(accountID, bucketName), domain, nil)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error creating R2 custom domain %q for bucket %q: %w", domain.Domain, bucketName, err))
+	}
+
+	d.SetId(domain.Domain)
+
+	return resourceCloudflareR2CustomDomainRead(ctx, d, meta)
+}
+
+func resourceCloudflareR2CustomDomainRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	accountID := d.Get(consts.AccountIDSchemaKey).(string)
+	bucketName := d.Get("bucket_name").(string)
+
+	res, err := client.Raw(ctx, http.MethodGet, r2CustomDomainsPath(accountID, bucketName)+"/"+d.Id(), nil, nil)
+	if err != nil {
+		var notFoundError *cloudflare.NotFoundError
+		if errors.As(err, &notFoundError) {
+			tflog.Info(ctx, fmt.Sprintf("R2 custom domain %q for bucket %q not found", d.Id(), bucketName))
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("error reading R2 custom domain %q for bucket %q: %w", d.Id(), bucketName, err))
+	}
+
+	var domain r2CustomDomain
+	if err := json.Unmarshal(res, &domain); err != nil {
+		return diag.FromErr(fmt.Errorf("failed to unmarshal R2 custom domain response: %w", err))
+	}
+
+	d.Set("domain", domain.Domain)
+	d.Set(consts.ZoneIDSchemaKey, domain.ZoneID)
+	d.Set("enabled", domain.Enabled)
+	d.Set("min_tls", domain.MinTLS)
+
+	if domain.Status != nil {
+		d.Set("status", domain.Status.SSL)
+		d.Set("ownership", domain.Status.Ownership)
+	}
+
+	return nil
+}
+
+func resourceCloudflareR2CustomDomainUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	accountID := d.Get(consts.AccountIDSchemaKey).(string)
+	bucketName := d.Get("bucket_name").(string)
+	domain := r2CustomDomain{
+		Enabled: d.Get("enabled").(bool),
+		MinTLS:  d.Get("min_tls").(string),
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Updating Cloudflare R2 custom domain %q for bucket %q", d.Id(), bucketName))
+
+	_, err := client.Raw(ctx, http.MethodPut, r2CustomDomainsPath(accountID, bucketName)+"/"+d.Id(), domain, nil)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error updating R2 custom domain %q for bucket %q: %w", d.Id(), bucketName, err))
+	}
+
+	return resourceCloudflareR2CustomDomainRead(ctx, d, meta)
+}
+
+func resourceCloudflareR2CustomDomainDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	accountID := d.Get(consts.AccountIDSchemaKey).(string)
+	bucketName := d.Get("bucket_name").(string)
+
+	tflog.Debug(ctx, fmt.Sprintf("Deleting Cloudflare R2 custom domain %q for bucket %q", d.Id(), bucketName))
+
+	_, err := client.Raw(ctx, http.MethodDelete, r2CustomDomainsPath(accountID, bucketName)+"/"+d.Id(), nil, nil)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error deleting R2 custom domain %q for bucket %q: %w", d.Id(), bucketName, err))
+	}
+
+	return nil
+}
+
+func resourceCloudflareR2CustomDomainImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	idAttr := strings.SplitN(d.Id(), "/", 3)
+
+	if len(idAttr) != 3 {
+		return nil, fmt.Errorf("invalid id (\"%s\") specified, should be in format \"accountID/bucketName/domain\"", d.Id())
+	}
+
+	accountID, bucketName, domain := idAttr[0], idAttr[1], idAttr[2]
+
+	tflog.Debug(ctx, fmt.Sprintf("Importing Cloudflare R2 custom domain: %s for bucket %s in account %s", domain, bucketName, accountID))
+
+	d.Set(consts.AccountIDSchemaKey, accountID)
+	d.Set("bucket_name", bucketName)
+	d.SetId(domain)
+
+	resourceCloudflareR2CustomDomainRead(ctx, d, meta)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func r2CustomDomainsPath(accountID, bucketName string) string {
+	return fmt.Sprintf("/accounts/%s/r2/buckets/%s/domains/custom", accountID, bucketName)
+}
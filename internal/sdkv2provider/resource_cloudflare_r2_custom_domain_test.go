@@ -0,0 +1,90 @@
+package sdkv2provider
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccCloudflareR2CustomDomain_Basic(t *testing.T) {
+	rnd := generateRandomResourceName()
+	name := "cloudflare_r2_custom_domain." + rnd
+	accountID := os.Getenv("CLOUDFLARE_ACCOUNT_ID")
+	zoneID := os.Getenv("CLOUDFLARE_ZONE_ID")
+	bucketName := os.Getenv("CLOUDFLARE_R2_BUCKET_NAME")
+	domain := fmt.Sprintf("%s.%s", rnd, os.Getenv("CLOUDFLARE_DOMAIN"))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckR2Bucket(t)
+		},
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareR2CustomDomainConfig(rnd, accountID, bucketName, domain, zoneID, true, "1.2"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "account_id", accountID),
+					resource.TestCheckResourceAttr(name, "bucket_name", bucketName),
+					resource.TestCheckResourceAttr(name, "domain", domain),
+					resource.TestCheckResourceAttr(name, "zone_id", zoneID),
+					resource.TestCheckResourceAttr(name, "enabled", "true"),
+					resource.TestCheckResourceAttr(name, "min_tls", "1.2"),
+					resource.TestCheckResourceAttrSet(name, "status"),
+					resource.TestCheckResourceAttrSet(name, "ownership"),
+				),
+			},
+			{
+				Config: testAccCloudflareR2CustomDomainConfig(rnd, accountID, bucketName, domain, zoneID, false, "1.3"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "enabled", "false"),
+					resource.TestCheckResourceAttr(name, "min_tls", "1.3"),
+				),
+			},
+			{
+				ResourceName:        name,
+				ImportState:         true,
+				ImportStateIdPrefix: fmt.Sprintf("%s/%s/", accountID, bucketName),
+				ImportStateVerify:   true,
+			},
+		},
+	})
+}
+
+func TestAccCloudflareR2CustomDomain_InvalidMinTLS(t *testing.T) {
+	rnd := generateRandomResourceName()
+	accountID := os.Getenv("CLOUDFLARE_ACCOUNT_ID")
+	zoneID := os.Getenv("CLOUDFLARE_ZONE_ID")
+	bucketName := os.Getenv("CLOUDFLARE_R2_BUCKET_NAME")
+	domain := fmt.Sprintf("%s.%s", rnd, os.Getenv("CLOUDFLARE_DOMAIN"))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckR2Bucket(t)
+		},
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCloudflareR2CustomDomainConfig(rnd, accountID, bucketName, domain, zoneID, true, "1.4"),
+				ExpectError: regexp.MustCompile(`expected min_tls to be one of`),
+			},
+		},
+	})
+}
+
+func testAccCloudflareR2CustomDomainConfig(rnd, accountID, bucketName, domain, zoneID string, enabled bool, minTLS string) string {
+	return fmt.Sprintf(`
+resource "cloudflare_r2_custom_domain" "%[1]s" {
+	account_id  = "%[2]s"
+	bucket_name = "%[3]s"
+	domain      = "%[4]s"
+	zone_id     = "%[5]s"
+	enabled     = %[6]t
+	min_tls     = "%[7]s"
+}
+`, rnd, accountID, bucketName, domain, zoneID, enabled, minTLS)
+}
@@ -0,0 +1,119 @@
+package sdkv2provider
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/cloudflare/terraform-provider-cloudflare/internal/consts"
+	cloudflare "github.com/curtislarson/cloudflare-go"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// regionalTieredCache is the Regional Tiered Cache setting of a zone.
+type regionalTieredCache struct {
+	ID         string `json:"id,omitempty"`
+	Value      string `json:"value"`
+	Editable   bool   `json:"editable,omitempty"`
+	ModifiedOn string `json:"modified_on,omitempty"`
+}
+
+func resourceCloudflareRegionalTieredCache() *schema.Resource {
+	return &schema.Resource{
+		Schema:        resourceCloudflareRegionalTieredCacheSchema(),
+		CreateContext: resourceCloudflareRegionalTieredCacheUpdate,
+		ReadContext:   resourceCloudflareRegionalTieredCacheRead,
+		UpdateContext: resourceCloudflareRegionalTieredCacheUpdate,
+		DeleteContext: resourceCloudflareRegionalTieredCacheDelete,
+		Description: heredoc.Doc(`
+			Provides a resource which manages Cloudflare Regional Tiered Cache
+			settings. This adds a regional hub layer on top of the tiered cache
+			topology of your zone.
+		`),
+	}
+}
+
+func resourceCloudflareRegionalTieredCacheRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+
+	tflog.Info(ctx, fmt.Sprintf("Reading Regional Tiered Cache in zone %q", d.Id()))
+
+	regionalTieredCache, err := getRegionalTieredCache(ctx, client, d.Id())
+	if err != nil {
+		var notFoundError *cloudflare.NotFoundError
+		if errors.As(err, &notFoundError) {
+			tflog.Info(ctx, fmt.Sprintf("Regional Tiered Cache for zone %q not found", d.Id()))
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("error reading regional tiered cache for zone %q: %w", d.Id(), err))
+	}
+
+	d.Set(consts.ZoneIDSchemaKey, d.Id())
+	d.Set("value", regionalTieredCache.Value)
+
+	return nil
+}
+
+func resourceCloudflareRegionalTieredCacheUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+
+	zoneID := d.Get(consts.ZoneIDSchemaKey).(string)
+	d.SetId(zoneID)
+
+	value := d.Get("value").(string)
+	tflog.Info(ctx, fmt.Sprintf("Setting Regional Tiered Cache to %q for zone ID: %q", value, d.Id()))
+
+	_, err := updateRegionalTieredCache(ctx, client, d.Id(), value)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error setting regional tiered cache for zone %q: %w", d.Id(), err))
+	}
+
+	return resourceCloudflareRegionalTieredCacheRead(ctx, d, meta)
+}
+
+func resourceCloudflareRegionalTieredCacheDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+
+	tflog.Info(ctx, fmt.Sprintf("Resetting Regional Tiered Cache for zone ID: %q", d.Id()))
+
+	_, err := updateRegionalTieredCache(ctx, client, d.Id(), "off")
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error resetting regional tiered cache for zone %q: %w", d.Id(), err))
+	}
+
+	return nil
+}
+
+func getRegionalTieredCache(ctx context.Context, client *cloudflare.API, zoneID string) (regionalTieredCache, error) {
+	res, err := client.Raw(ctx, http.MethodGet, fmt.Sprintf("/zones/%s/cache/regional_tiered_cache", zoneID), nil, nil)
+	if err != nil {
+		return regionalTieredCache{}, err
+	}
+
+	var setting regionalTieredCache
+	if err := json.Unmarshal(res, &setting); err != nil {
+		return regionalTieredCache{}, err
+	}
+
+	return setting, nil
+}
+
+func updateRegionalTieredCache(ctx context.Context, client *cloudflare.API, zoneID, value string) (regionalTieredCache, error) {
+	res, err := client.Raw(ctx, http.MethodPatch, fmt.Sprintf("/zones/%s/cache/regional_tiered_cache", zoneID), regionalTieredCache{Value: value}, nil)
+	if err != nil {
+		return regionalTieredCache{}, err
+	}
+
+	var setting regionalTieredCache
+	if err := json.Unmarshal(res, &setting); err != nil {
+		return regionalTieredCache{}, err
+	}
+
+	return setting, nil
+}
@@ -0,0 +1,45 @@
+package sdkv2provider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func testRegionalTieredCacheConfig(rnd, zoneID, value string) string {
+	return fmt.Sprintf(`
+resource "cloudflare_regional_tiered_cache" "%[1]s" {
+	zone_id = "%[2]s"
+	value   = "%[3]s"
+}
+`, rnd, zoneID, value)
+}
+
+func TestAccCloudflareRegionalTieredCache_Basic(t *testing.T) {
+	rnd := generateRandomResourceName()
+	name := "cloudflare_regional_tiered_cache." + rnd
+	zoneID := os.Getenv("CLOUDFLARE_ZONE_ID")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testRegionalTieredCacheConfig(rnd, zoneID, "on"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "zone_id", zoneID),
+					resource.TestCheckResourceAttr(name, "value", "on"),
+				),
+			},
+			{
+				Config: testRegionalTieredCacheConfig(rnd, zoneID, "off"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "zone_id", zoneID),
+					resource.TestCheckResourceAttr(name, "value", "off"),
+				),
+			},
+		},
+	})
+}
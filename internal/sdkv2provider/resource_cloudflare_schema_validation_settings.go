@@ -0,0 +1,108 @@
+package sdkv2provider
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/cloudflare/terraform-provider-cloudflare/internal/consts"
+	cloudflare "github.com/curtislarson/cloudflare-go"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// schemaValidationSettings are the zone-wide API Shield schema validation
+// settings.
+type schemaValidationSettings struct {
+	ValidationDefaultMitigationAction string `json:"validation_default_mitigation_action"`
+}
+
+func resourceCloudflareSchemaValidationSettings() *schema.Resource {
+	return &schema.Resource{
+		Schema:        resourceCloudflareSchemaValidationSettingsSchema(),
+		CreateContext: resourceCloudflareSchemaValidationSettingsUpdate,
+		ReadContext:   resourceCloudflareSchemaValidationSettingsRead,
+		UpdateContext: resourceCloudflareSchemaValidationSettingsUpdate,
+		DeleteContext: resourceCloudflareSchemaValidationSettingsDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceCloudflareSchemaValidationSettingsImport,
+		},
+		Description: heredoc.Doc(`
+			Provides a resource to manage the zone-wide API Shield schema
+			validation settings.
+		`),
+	}
+}
+
+func resourceCloudflareSchemaValidationSettingsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+
+	tflog.Info(ctx, fmt.Sprintf("Reading schema validation settings in zone %q", d.Id()))
+
+	res, err := client.Raw(ctx, http.MethodGet, fmt.Sprintf("/zones/%s/api_gateway/settings/schema_validation", d.Id()), nil, nil)
+	if err != nil {
+		var notFoundError *cloudflare.NotFoundError
+		if errors.As(err, &notFoundError) {
+			tflog.Info(ctx, fmt.Sprintf("Schema validation settings for zone %q not found", d.Id()))
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("error reading schema validation settings for zone %q: %w", d.Id(), err))
+	}
+
+	var settings schemaValidationSettings
+	if err := json.Unmarshal(res, &settings); err != nil {
+		return diag.FromErr(fmt.Errorf("failed to unmarshal schema validation settings response: %w", err))
+	}
+
+	d.Set(consts.ZoneIDSchemaKey, d.Id())
+	d.Set("validation_default_mitigation_action", settings.ValidationDefaultMitigationAction)
+
+	return nil
+}
+
+func resourceCloudflareSchemaValidationSettingsUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	zoneID := d.Get(consts.ZoneIDSchemaKey).(string)
+	d.SetId(zoneID)
+
+	settings := schemaValidationSettings{
+		ValidationDefaultMitigationAction: d.Get("validation_default_mitigation_action").(string),
+	}
+
+	if err := updateSchemaValidationSettings(ctx, meta.(*cloudflare.API), zoneID, settings); err != nil {
+		return diag.FromErr(fmt.Errorf("error setting schema validation settings for zone %q: %w", zoneID, err))
+	}
+
+	return resourceCloudflareSchemaValidationSettingsRead(ctx, d, meta)
+}
+
+func resourceCloudflareSchemaValidationSettingsDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	tflog.Info(ctx, fmt.Sprintf("Resetting schema validation settings for zone ID: %q", d.Id()))
+
+	settings := schemaValidationSettings{ValidationDefaultMitigationAction: "none"}
+
+	if err := updateSchemaValidationSettings(ctx, meta.(*cloudflare.API), d.Id(), settings); err != nil {
+		return diag.FromErr(fmt.Errorf("error resetting schema validation settings for zone %q: %w", d.Id(), err))
+	}
+
+	return nil
+}
+
+func resourceCloudflareSchemaValidationSettingsImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	d.Set(consts.ZoneIDSchemaKey, d.Id())
+
+	resourceCloudflareSchemaValidationSettingsRead(ctx, d, meta)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func updateSchemaValidationSettings(ctx context.Context, client *cloudflare.API, zoneID string, settings schemaValidationSettings) error {
+	tflog.Debug(ctx, fmt.Sprintf("Setting schema validation settings to struct: %+v for zone ID: %q", settings, zoneID))
+
+	_, err := client.Raw(ctx, http.MethodPut, fmt.Sprintf("/zones/%s/api_gateway/settings/schema_validation", zoneID), settings, nil)
+	return err
+}
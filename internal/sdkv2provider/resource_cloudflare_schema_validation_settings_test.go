@@ -0,0 +1,50 @@
+package sdkv2provider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccCloudflareSchemaValidationSettings_Basic(t *testing.T) {
+	rnd := generateRandomResourceName()
+	name := "cloudflare_schema_validation_settings." + rnd
+	zoneID := os.Getenv("CLOUDFLARE_ZONE_ID")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareSchemaValidationSettingsConfig(rnd, zoneID, "log"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "zone_id", zoneID),
+					resource.TestCheckResourceAttr(name, "validation_default_mitigation_action", "log"),
+				),
+			},
+			{
+				Config: testAccCloudflareSchemaValidationSettingsConfig(rnd, zoneID, "block"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "zone_id", zoneID),
+					resource.TestCheckResourceAttr(name, "validation_default_mitigation_action", "block"),
+				),
+			},
+			{
+				ResourceName:      name,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCloudflareSchemaValidationSettingsConfig(rnd, zoneID, action string) string {
+	return fmt.Sprintf(`
+resource "cloudflare_schema_validation_settings" "%[1]s" {
+	zone_id                              = "%[2]s"
+	validation_default_mitigation_action = "%[3]s"
+}
+`, rnd, zoneID, action)
+}
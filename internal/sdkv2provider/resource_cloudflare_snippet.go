@@ -0,0 +1,288 @@
+package sdkv2provider
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net/http"
+	"net/textproto"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/cloudflare/terraform-provider-cloudflare/internal/consts"
+	cloudflare "github.com/curtislarson/cloudflare-go"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// snippet is a snippet of a zone.
+type snippet struct {
+	SnippetName string `json:"snippet_name"`
+	CreatedOn   string `json:"created_on"`
+	ModifiedOn  string `json:"modified_on"`
+}
+
+func resourceCloudflareSnippet() *schema.Resource {
+	return &schema.Resource{
+		Schema:        resourceCloudflareSnippetSchema(),
+		CreateContext: resourceCloudflareSnippetUpdate,
+		ReadContext:   resourceCloudflareSnippetRead,
+		UpdateContext: resourceCloudflareSnippetUpdate,
+		DeleteContext: resourceCloudflareSnippetDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceCloudflareSnippetImport,
+		},
+		CustomizeDiff: resourceCloudflareSnippetCustomizeDiff,
+		Description: heredoc.Doc(`
+			Provides a resource to manage Snippets, which run JavaScript on
+			requests to a zone to modify them or their responses.
+		`),
+	}
+}
+
+func resourceCloudflareSnippetRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	zoneID := d.Get(consts.ZoneIDSchemaKey).(string)
+
+	res, err := client.Raw(ctx, http.MethodGet, fmt.Sprintf("/zones/%s/snippets/%s", zoneID, d.Id()), nil, nil)
+	if err != nil {
+		var notFoundError *cloudflare.NotFoundError
+		if errors.As(err, &notFoundError) {
+			tflog.Info(ctx, fmt.Sprintf("Snippet %q not found in zone %q", d.Id(), zoneID))
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("error reading snippet %q: %w", d.Id(), err))
+	}
+
+	var s snippet
+	if err := json.Unmarshal(res, &s); err != nil {
+		return diag.FromErr(fmt.Errorf("failed to unmarshal snippet response: %w", err))
+	}
+
+	content, err := getSnippetContent(ctx, client, zoneID, d.Id(), d.Get("main_module").(string))
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error reading content of snippet %q: %w", d.Id(), err))
+	}
+
+	d.Set("name", s.SnippetName)
+	d.Set("content_sha256", snippetContentSHA256(content))
+
+	// Content is only known from the API after an import; otherwise the
+	// configured content is kept so formatting differences don't show.
+	if d.Get("content").(string) == "" {
+		d.Set("content", content)
+	}
+
+	return nil
+}
+
+func resourceCloudflareSnippetUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	zoneID := d.Get(consts.ZoneIDSchemaKey).(string)
+	name := d.Get("name").(string)
+	mainModule := d.Get("main_module").(string)
+
+	tflog.Debug(ctx, fmt.Sprintf("Uploading Cloudflare snippet %q for zone %q", name, zoneID))
+
+	body := &bytes.Buffer{}
+	w := multipart.NewWriter(body)
+
+	metadata, err := json.Marshal(map[string]string{"main_module": mainModule})
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error writing snippet upload: %w", err))
+	}
+	if err := w.WriteField("metadata", string(metadata)); err != nil {
+		return diag.FromErr(fmt.Errorf("error writing snippet upload: %w", err))
+	}
+
+	header := make(textproto.MIMEHeader)
+	header.Set("Content-Disposition", fmt.Sprintf(`form-data; name=%q; filename=%q`, mainModule, mainModule))
+	header.Set("Content-Type", "application/javascript+module")
+	file, err := w.CreatePart(header)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error writing snippet upload: %w", err))
+	}
+	if _, err := file.Write([]byte(d.Get("content").(string))); err != nil {
+		return diag.FromErr(fmt.Errorf("error writing snippet upload: %w", err))
+	}
+	if err := w.Close(); err != nil {
+		return diag.FromErr(fmt.Errorf("error writing snippet upload: %w", err))
+	}
+
+	headers := make(http.Header)
+	headers.Set("Content-Type", w.FormDataContentType())
+
+	if _, err := client.Raw(ctx, http.MethodPut, fmt.Sprintf("/zones/%s/snippets/%s", zoneID, name), body.Bytes(), headers); err != nil {
+		return diag.FromErr(fmt.Errorf("error uploading snippet %q for zone %q: %w", name, zoneID, err))
+	}
+
+	d.SetId(name)
+
+	return resourceCloudflareSnippetRead(ctx, d, meta)
+}
+
+func resourceCloudflareSnippetDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	zoneID := d.Get(consts.ZoneIDSchemaKey).(string)
+
+	tflog.Debug(ctx, fmt.Sprintf("Deleting Cloudflare snippet %q for zone %q", d.Id(), zoneID))
+
+	if _, err := client.Raw(ctx, http.MethodDelete, fmt.Sprintf("/zones/%s/snippets/%s", zoneID, d.Id()), nil, nil); err != nil {
+		return diag.FromErr(fmt.Errorf("error deleting snippet %q for zone %q: %w", d.Id(), zoneID, err))
+	}
+
+	return nil
+}
+
+func resourceCloudflareSnippetImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	idAttr := strings.SplitN(d.Id(), "/", 2)
+
+	if len(idAttr) != 2 {
+		return nil, fmt.Errorf("invalid id (\"%s\") specified, should be in format \"zoneID/snippetName\"", d.Id())
+	}
+
+	zoneID, name := idAttr[0], idAttr[1]
+
+	tflog.Debug(ctx, fmt.Sprintf("Importing Cloudflare snippet: name %s for zone %s", name, zoneID))
+
+	d.Set(consts.ZoneIDSchemaKey, zoneID)
+	d.Set("main_module", "main.js")
+	d.SetId(name)
+
+	resourceCloudflareSnippetRead(ctx, d, meta)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// resourceCloudflareSnippetCustomizeDiff plans an update when the content
+// deployed to Cloudflare no longer matches the configuration, such as after
+// the snippet was edited in the dashboard.
+func resourceCloudflareSnippetCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if d.Id() == "" || !d.NewValueKnown("content") {
+		return nil
+	}
+
+	if snippetContentSHA256(d.Get("content").(string)) != d.Get("content_sha256").(string) {
+		return d.SetNewComputed("content_sha256")
+	}
+
+	return nil
+}
+
+// snippetContentTransport rewrites the multipart form data returned by the
+// snippet content endpoint into the JSON envelope the API client expects, with
+// the result mapping each module name to its content.
+type snippetContentTransport struct {
+	base http.RoundTripper
+}
+
+func (t *snippetContentTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	resp, err := t.base.RoundTrip(req)
+	if err != nil || resp.StatusCode != http.StatusOK {
+		return resp, err
+	}
+
+	mediaType, params, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+	if err != nil || mediaType != "multipart/form-data" {
+		return resp, nil
+	}
+	defer resp.Body.Close()
+
+	modules := make(map[string]string)
+	r := multipart.NewReader(resp.Body, params["boundary"])
+	for {
+		part, err := r.NextPart()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse snippet content response: %w", err)
+		}
+
+		content, err := io.ReadAll(part)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read snippet content response: %w", err)
+		}
+
+		name := part.FileName()
+		if name == "" {
+			name = part.FormName()
+		}
+		modules[name] = string(content)
+	}
+
+	result, err := json.Marshal(modules)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := json.Marshal(cloudflare.RawResponse{
+		Response: cloudflare.Response{Success: true, Errors: []cloudflare.ResponseInfo{}, Messages: []cloudflare.ResponseInfo{}},
+		Result:   result,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	resp.Header.Set("Content-Type", "application/json")
+	resp.Header.Del("Content-Length")
+	resp.ContentLength = int64(len(body))
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+
+	return resp, nil
+}
+
+// getSnippetContent downloads the main module of a snippet. The request is
+// made with a copy of the configured client which only differs in parsing the
+// multipart response, so it keeps the client's authentication, rate limiter,
+// retries and debug logging.
+func getSnippetContent(ctx context.Context, client *cloudflare.API, zoneID, name, mainModule string) (string, error) {
+	contentClient := *client
+	if err := cloudflare.HTTPClient(&http.Client{Transport: &snippetContentTransport{base: http.DefaultTransport}})(&contentClient); err != nil {
+		return "", err
+	}
+
+	res, err := contentClient.Raw(ctx, http.MethodGet, fmt.Sprintf("/zones/%s/snippets/%s/content", zoneID, name), nil, nil)
+	if err != nil {
+		return "", err
+	}
+
+	var modules map[string]string
+	if err := json.Unmarshal(res, &modules); err != nil {
+		return "", fmt.Errorf("failed to unmarshal snippet content response: %w", err)
+	}
+
+	content, ok := modules[mainModule]
+	if !ok {
+		return "", fmt.Errorf("module %q not found in snippet content", mainModule)
+	}
+
+	return content, nil
+}
+
+// normalizeSnippetContent removes differences in line endings and trailing
+// whitespace which don't change the behaviour of a snippet.
+func normalizeSnippetContent(content string) string {
+	lines := strings.Split(strings.ReplaceAll(content, "\r\n", "\n"), "\n")
+	for i, line := range lines {
+		lines[i] = strings.TrimRight(line, " \t")
+	}
+
+	return strings.TrimRight(strings.Join(lines, "\n"), "\n")
+}
+
+func snippetContentSHA256(content string) string {
+	return fmt.Sprintf("%x", sha256.Sum256([]byte(normalizeSnippetContent(content))))
+}
+
+func suppressSnippetContentWhitespace(k, old, new string, d *schema.ResourceData) bool {
+	return normalizeSnippetContent(old) == normalizeSnippetContent(new)
+}
@@ -0,0 +1,111 @@
+package sdkv2provider
+
+import (
+	"context"
+	"fmt"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+
+	cloudflare "github.com/curtislarson/cloudflare-go"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccCloudflareSnippet_Basic(t *testing.T) {
+	rnd := generateRandomResourceName()
+	name := "cloudflare_snippet." + rnd
+	zoneID := os.Getenv("CLOUDFLARE_ZONE_ID")
+	content := `export default { async fetch(request) { return fetch(request); } }`
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareSnippetConfig(rnd, zoneID, content),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "zone_id", zoneID),
+					resource.TestCheckResourceAttr(name, "name", rnd),
+					resource.TestCheckResourceAttr(name, "main_module", "main.js"),
+					resource.TestCheckResourceAttr(name, "content_sha256", snippetContentSHA256(content)),
+				),
+			},
+			{
+				// Trailing whitespace doesn't change the snippet.
+				Config:   testAccCloudflareSnippetConfig(rnd, zoneID, content+"  \n\n"),
+				PlanOnly: true,
+			},
+			{
+				ResourceName:        name,
+				ImportState:         true,
+				ImportStateIdPrefix: fmt.Sprintf("%s/", zoneID),
+				ImportStateVerify:   true,
+			},
+		},
+	})
+}
+
+func TestSnippetContentSHA256(t *testing.T) {
+	content := "export default {\n  async fetch(request) {\n    return fetch(request);\n  }\n}"
+
+	assert.Equal(t, snippetContentSHA256(content), snippetContentSHA256(strings.ReplaceAll(content, "\n", "\r\n")))
+	assert.Equal(t, snippetContentSHA256(content), snippetContentSHA256(strings.ReplaceAll(content, "\n", "  \n")+"\n\n"))
+	assert.NotEqual(t, snippetContentSHA256(content), snippetContentSHA256(strings.ReplaceAll(content, "request", "req")))
+}
+
+func TestValidateSnippetContentSize(t *testing.T) {
+	_, errs := validateSnippetContentSize("export default {}", "content")
+	assert.Empty(t, errs)
+
+	_, errs = validateSnippetContentSize("", "content")
+	assert.Len(t, errs, 1)
+
+	_, errs = validateSnippetContentSize(strings.Repeat("a", snippetMaxContentSize+1), "content")
+	assert.Len(t, errs, 1)
+}
+
+func TestGetSnippetContent(t *testing.T) {
+	var authorization string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/zones/zone/snippets/example/content" {
+			http.NotFound(w, r)
+			return
+		}
+		authorization = r.Header.Get("Authorization")
+
+		mw := multipart.NewWriter(w)
+		w.Header().Set("Content-Type", mw.FormDataContentType())
+		part, _ := mw.CreateFormFile("main.js", "main.js")
+		part.Write([]byte("export default {}"))
+		part, _ = mw.CreateFormFile("helpers.js", "helpers.js")
+		part.Write([]byte("export const x = 1"))
+		mw.Close()
+	}))
+	defer server.Close()
+
+	config := Config{APIToken: "token", Options: []cloudflare.Option{cloudflare.BaseURL(server.URL)}}
+	client, err := config.Client(context.Background())
+	assert.NoError(t, err)
+
+	content, err := getSnippetContent(context.Background(), client, "zone", "example", "main.js")
+	assert.NoError(t, err)
+	assert.Equal(t, "export default {}", content)
+	assert.Equal(t, "Bearer token", authorization)
+
+	_, err = getSnippetContent(context.Background(), client, "zone", "example", "missing.js")
+	assert.EqualError(t, err, `module "missing.js" not found in snippet content`)
+}
+
+func testAccCloudflareSnippetConfig(rnd, zoneID, content string) string {
+	return fmt.Sprintf(`
+resource "cloudflare_snippet" "%[1]s" {
+	zone_id = "%[2]s"
+	name    = "%[1]s"
+	content = %[3]q
+}
+`, rnd, zoneID, content)
+}
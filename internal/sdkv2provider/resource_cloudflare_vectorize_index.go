@@ -0,0 +1,140 @@
+package sdkv2provider
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/cloudflare/terraform-provider-cloudflare/internal/consts"
+	cloudflare "github.com/curtislarson/cloudflare-go"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// vectorizeIndex is a Vectorize index of an account.
+type vectorizeIndex struct {
+	Name        string               `json:"name"`
+	Description string               `json:"description,omitempty"`
+	Config      vectorizeIndexConfig `json:"config"`
+	CreatedOn   string               `json:"created_on,omitempty"`
+	ModifiedOn  string               `json:"modified_on,omitempty"`
+}
+
+type vectorizeIndexConfig struct {
+	Dimensions int    `json:"dimensions"`
+	Metric     string `json:"metric"`
+}
+
+func resourceCloudflareVectorizeIndex() *schema.Resource {
+	return &schema.Resource{
+		Schema:        resourceCloudflareVectorizeIndexSchema(),
+		CreateContext: resourceCloudflareVectorizeIndexCreate,
+		ReadContext:   resourceCloudflareVectorizeIndexRead,
+		DeleteContext: resourceCloudflareVectorizeIndexDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceCloudflareVectorizeIndexImport,
+		},
+		Description: heredoc.Doc(`
+			Provides a resource to manage a Vectorize index, which stores
+			vector embeddings for similarity search from Workers.
+		`),
+	}
+}
+
+func resourceCloudflareVectorizeIndexCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	accountID := d.Get(consts.AccountIDSchemaKey).(string)
+
+	index := vectorizeIndex{
+		Name:        d.Get("name").(string),
+		Description: d.Get("description").(string),
+		Config: vectorizeIndexConfig{
+			Dimensions: d.Get("config.0.dimensions").(int),
+			Metric:     d.Get("config.0.metric").(string),
+		},
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Creating Cloudflare Vectorize index %q in account %q", index.Name, accountID))
+
+	_, err := client.Raw(ctx, http.MethodPost, fmt.Sprintf("/accounts/%s/vectorize/v2/indexes", accountID), index, nil)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error creating Vectorize index %q: %w", index.Name, err))
+	}
+
+	d.SetId(index.Name)
+
+	return resourceCloudflareVectorizeIndexRead(ctx, d, meta)
+}
+
+func resourceCloudflareVectorizeIndexRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	accountID := d.Get(consts.AccountIDSchemaKey).(string)
+
+	res, err := client.Raw(ctx, http.MethodGet, fmt.Sprintf("/accounts/%s/vectorize/v2/indexes/%s", accountID, d.Id()), nil, nil)
+	if err != nil {
+		var notFoundError *cloudflare.NotFoundError
+		if errors.As(err, &notFoundError) {
+			tflog.Info(ctx, fmt.Sprintf("Vectorize index %q not found", d.Id()))
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("error reading Vectorize index %q: %w", d.Id(), err))
+	}
+
+	var index vectorizeIndex
+	if err := json.Unmarshal(res, &index); err != nil {
+		return diag.FromErr(fmt.Errorf("failed to unmarshal Vectorize index response: %w", err))
+	}
+
+	d.Set("name", index.Name)
+	d.Set("description", index.Description)
+	d.Set("created_on", index.CreatedOn)
+	d.Set("modified_on", index.ModifiedOn)
+
+	if err := d.Set("config", []map[string]interface{}{{
+		"dimensions": index.Config.Dimensions,
+		"metric":     index.Config.Metric,
+	}}); err != nil {
+		return diag.FromErr(fmt.Errorf("failed to set config: %w", err))
+	}
+
+	return nil
+}
+
+func resourceCloudflareVectorizeIndexDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	accountID := d.Get(consts.AccountIDSchemaKey).(string)
+
+	tflog.Debug(ctx, fmt.Sprintf("Deleting Cloudflare Vectorize index %q in account %q", d.Id(), accountID))
+
+	_, err := client.Raw(ctx, http.MethodDelete, fmt.Sprintf("/accounts/%s/vectorize/v2/indexes/%s", accountID, d.Id()), nil, nil)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error deleting Vectorize index %q: %w", d.Id(), err))
+	}
+
+	return nil
+}
+
+func resourceCloudflareVectorizeIndexImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	idAttr := strings.SplitN(d.Id(), "/", 2)
+
+	if len(idAttr) != 2 {
+		return nil, fmt.Errorf("invalid id (\"%s\") specified, should be in format \"accountID/indexName\"", d.Id())
+	}
+
+	accountID, indexName := idAttr[0], idAttr[1]
+
+	tflog.Debug(ctx, fmt.Sprintf("Importing Cloudflare Vectorize index: name %s for account %s", indexName, accountID))
+
+	d.Set(consts.AccountIDSchemaKey, accountID)
+	d.SetId(indexName)
+
+	resourceCloudflareVectorizeIndexRead(ctx, d, meta)
+
+	return []*schema.ResourceData{d}, nil
+}
@@ -0,0 +1,80 @@
+package sdkv2provider
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccCloudflareVectorizeIndex_Basic(t *testing.T) {
+	rnd := generateRandomResourceName()
+	name := "cloudflare_vectorize_index." + rnd
+	accountID := os.Getenv("CLOUDFLARE_ACCOUNT_ID")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAccount(t)
+		},
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareVectorizeIndexConfig(rnd, accountID, 768, "cosine"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "account_id", accountID),
+					resource.TestCheckResourceAttr(name, "name", rnd),
+					resource.TestCheckResourceAttr(name, "config.0.dimensions", "768"),
+					resource.TestCheckResourceAttr(name, "config.0.metric", "cosine"),
+					resource.TestCheckResourceAttrSet(name, "created_on"),
+				),
+			},
+			{
+				ResourceName:        name,
+				ImportState:         true,
+				ImportStateIdPrefix: fmt.Sprintf("%s/", accountID),
+				ImportStateVerify:   true,
+			},
+		},
+	})
+}
+
+func TestAccCloudflareVectorizeIndex_InvalidConfig(t *testing.T) {
+	rnd := generateRandomResourceName()
+	accountID := os.Getenv("CLOUDFLARE_ACCOUNT_ID")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAccount(t)
+		},
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCloudflareVectorizeIndexConfig(rnd, accountID, 4096, "cosine"),
+				ExpectError: regexp.MustCompile(`expected config.0.dimensions to be in the range \(1 - 1536\)`),
+			},
+			{
+				Config:      testAccCloudflareVectorizeIndexConfig(rnd, accountID, 768, "manhattan"),
+				ExpectError: regexp.MustCompile(`expected config.0.metric to be one of`),
+			},
+		},
+	})
+}
+
+func testAccCloudflareVectorizeIndexConfig(rnd, accountID string, dimensions int, metric string) string {
+	return fmt.Sprintf(`
+resource "cloudflare_vectorize_index" "%[1]s" {
+	account_id  = "%[2]s"
+	name        = "%[1]s"
+	description = "Terraform acceptance test index"
+
+	config {
+		dimensions = %[3]d
+		metric     = "%[4]s"
+	}
+}
+`, rnd, accountID, dimensions, metric)
+}
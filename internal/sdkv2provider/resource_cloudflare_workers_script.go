@@ -3,13 +3,16 @@ package sdkv2provider
 import (
 	"context"
 	"encoding/base64"
+	"encoding/json"
 	"fmt"
 	"io/ioutil"
+	"net/http"
 	"strings"
 
 	"github.com/MakeNowJust/heredoc/v2"
 	"github.com/cloudflare/terraform-provider-cloudflare/internal/consts"
 	cloudflare "github.com/curtislarson/cloudflare-go"
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -52,6 +55,82 @@ func getScriptData(d *schema.ResourceData, client *cloudflare.API) (ScriptData,
 	}, nil
 }
 
+// workerScriptSettings holds the script settings which the API client can't
+// include in the upload metadata, so they're managed through the script
+// settings endpoint directly. The compatibility settings are only read, as
+// they're set when the script is uploaded.
+type workerScriptSettings struct {
+	CompatibilityDate  string                      `json:"compatibility_date,omitempty"`
+	CompatibilityFlags []string                    `json:"compatibility_flags,omitempty"`
+	Observability      *workerScriptObservability  `json:"observability,omitempty"`
+	TailConsumers      *[]workerScriptTailConsumer `json:"tail_consumers,omitempty"`
+}
+
+type workerScriptObservability struct {
+	Enabled          bool     `json:"enabled"`
+	HeadSamplingRate *float64 `json:"head_sampling_rate,omitempty"`
+}
+
+type workerScriptTailConsumer struct {
+	Service     string `json:"service"`
+	Environment string `json:"environment,omitempty"`
+	Namespace   string `json:"namespace,omitempty"`
+}
+
+func workerScriptSettingsEndpoint(accountID, scriptName string) string {
+	return fmt.Sprintf("/accounts/%s/workers/scripts/%s/script-settings", accountID, scriptName)
+}
+
+// getWorkerScriptSettings reads the settings of a script, including the
+// compatibility settings which the script settings endpoint doesn't return.
+func getWorkerScriptSettings(ctx context.Context, client *cloudflare.API, accountID, scriptName string) (workerScriptSettings, error) {
+	res, err := client.Raw(ctx, http.MethodGet, fmt.Sprintf("/accounts/%s/workers/scripts/%s/settings", accountID, scriptName), nil, nil)
+	if err != nil {
+		return workerScriptSettings{}, err
+	}
+
+	var settings workerScriptSettings
+	if err := json.Unmarshal(res, &settings); err != nil {
+		return workerScriptSettings{}, fmt.Errorf("failed to unmarshal worker script settings response: %w", err)
+	}
+
+	return settings, nil
+}
+
+func updateWorkerScriptSettings(ctx context.Context, client *cloudflare.API, accountID, scriptName string, settings workerScriptSettings) error {
+	_, err := client.Raw(ctx, http.MethodPatch, workerScriptSettingsEndpoint(accountID, scriptName), settings, nil)
+	return err
+}
+
+func expandWorkerScriptObservability(d *schema.ResourceData) *workerScriptObservability {
+	observability := d.Get("observability").([]interface{})
+	if len(observability) == 0 || observability[0] == nil {
+		// Removing the block turns Workers Logs off.
+		return &workerScriptObservability{Enabled: false}
+	}
+
+	data := observability[0].(map[string]interface{})
+	return &workerScriptObservability{
+		Enabled:          data["enabled"].(bool),
+		HeadSamplingRate: cloudflare.Float64Ptr(data["head_sampling_rate"].(float64)),
+	}
+}
+
+func expandWorkerScriptTailConsumers(d *schema.ResourceData) *[]workerScriptTailConsumer {
+	consumers := make([]workerScriptTailConsumer, 0)
+
+	for _, rawData := range d.Get("tail_consumers").(*schema.Set).List() {
+		data := rawData.(map[string]interface{})
+		consumers = append(consumers, workerScriptTailConsumer{
+			Service:     data["service"].(string),
+			Environment: data["environment"].(string),
+			Namespace:   data["namespace"].(string),
+		})
+	}
+
+	return &consumers
+}
+
 type ScriptBindings map[string]cloudflare.WorkerBinding
 
 func getWorkerScriptBindings(ctx context.Context, accountId, scriptName string, client *cloudflare.API) (ScriptBindings, error) {
@@ -153,10 +232,12 @@ func resourceCloudflareWorkerScriptCreate(ctx context.Context, d *schema.Resourc
 	parseWorkerBindings(d, bindings)
 
 	_, err = client.UploadWorker(ctx, cloudflare.AccountIdentifier(accountID), cloudflare.CreateWorkerParams{
-		ScriptName: scriptData.Params.ScriptName,
-		Script:     scriptBody,
-		Module:     d.Get("module").(bool),
-		Bindings:   bindings,
+		ScriptName:         scriptData.Params.ScriptName,
+		Script:             scriptBody,
+		Module:             d.Get("module").(bool),
+		Bindings:           bindings,
+		CompatibilityDate:  d.Get("compatibility_date").(string),
+		CompatibilityFlags: expandInterfaceToStringList(d.Get("compatibility_flags").(*schema.Set).List()),
 	})
 	if err != nil {
 		return diag.FromErr(errors.Wrap(err, "error creating worker script"))
@@ -164,7 +245,21 @@ func resourceCloudflareWorkerScriptCreate(ctx context.Context, d *schema.Resourc
 
 	d.SetId(scriptData.ID)
 
-	return nil
+	var settings workerScriptSettings
+	if _, ok := d.GetOk("observability"); ok {
+		settings.Observability = expandWorkerScriptObservability(d)
+	}
+	if d.Get("tail_consumers").(*schema.Set).Len() > 0 {
+		settings.TailConsumers = expandWorkerScriptTailConsumers(d)
+	}
+
+	if settings.Observability != nil || settings.TailConsumers != nil {
+		if err := updateWorkerScriptSettings(ctx, client, accountID, scriptData.Params.ScriptName, settings); err != nil {
+			return diag.FromErr(errors.Wrap(err, "error updating worker script settings"))
+		}
+	}
+
+	return workerScriptCompatibilityFlagsWarnings(d)
 }
 
 func resourceCloudflareWorkerScriptRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -293,6 +388,51 @@ func resourceCloudflareWorkerScriptRead(ctx context.Context, d *schema.ResourceD
 		return diag.FromErr(fmt.Errorf("cannot set analytics engine bindings (%s): %w", d.Id(), err))
 	}
 
+	settings, err := getWorkerScriptSettings(ctx, client, accountID, scriptData.Params.ScriptName)
+	if err != nil {
+		return diag.FromErr(errors.Wrap(err, "error reading worker script settings"))
+	}
+
+	d.Set("compatibility_date", settings.CompatibilityDate)
+
+	if err := d.Set("compatibility_flags", settings.CompatibilityFlags); err != nil {
+		return diag.FromErr(fmt.Errorf("cannot set compatibility flags (%s): %w", d.Id(), err))
+	}
+
+	// Workers Logs are reported as disabled for scripts which never configured
+	// them, so a disabled block is only kept when one is already in state.
+	observability := make([]interface{}, 0)
+	_, hasObservability := d.GetOk("observability")
+	if settings.Observability != nil && (settings.Observability.Enabled || hasObservability) {
+		headSamplingRate := 1.0
+		if settings.Observability.HeadSamplingRate != nil {
+			headSamplingRate = *settings.Observability.HeadSamplingRate
+		}
+		observability = append(observability, map[string]interface{}{
+			"enabled":            settings.Observability.Enabled,
+			"head_sampling_rate": headSamplingRate,
+		})
+	}
+
+	if err := d.Set("observability", observability); err != nil {
+		return diag.FromErr(fmt.Errorf("cannot set observability (%s): %w", d.Id(), err))
+	}
+
+	tailConsumers := &schema.Set{F: schema.HashResource(tailConsumerResource)}
+	if settings.TailConsumers != nil {
+		for _, consumer := range *settings.TailConsumers {
+			tailConsumers.Add(map[string]interface{}{
+				"service":     consumer.Service,
+				"environment": consumer.Environment,
+				"namespace":   consumer.Namespace,
+			})
+		}
+	}
+
+	if err := d.Set("tail_consumers", tailConsumers); err != nil {
+		return diag.FromErr(fmt.Errorf("cannot set tail consumers (%s): %w", d.Id(), err))
+	}
+
 	d.SetId(scriptData.ID)
 
 	return nil
@@ -323,16 +463,32 @@ func resourceCloudflareWorkerScriptUpdate(ctx context.Context, d *schema.Resourc
 	parseWorkerBindings(d, bindings)
 
 	_, err = client.UploadWorker(ctx, cloudflare.AccountIdentifier(accountID), cloudflare.CreateWorkerParams{
-		ScriptName: scriptData.Params.ScriptName,
-		Script:     scriptBody,
-		Module:     d.Get("module").(bool),
-		Bindings:   bindings,
+		ScriptName:         scriptData.Params.ScriptName,
+		Script:             scriptBody,
+		Module:             d.Get("module").(bool),
+		Bindings:           bindings,
+		CompatibilityDate:  d.Get("compatibility_date").(string),
+		CompatibilityFlags: expandInterfaceToStringList(d.Get("compatibility_flags").(*schema.Set).List()),
 	})
 	if err != nil {
 		return diag.FromErr(errors.Wrap(err, "error updating worker script"))
 	}
 
-	return nil
+	var settings workerScriptSettings
+	if d.HasChange("observability") {
+		settings.Observability = expandWorkerScriptObservability(d)
+	}
+	if d.HasChange("tail_consumers") {
+		settings.TailConsumers = expandWorkerScriptTailConsumers(d)
+	}
+
+	if settings.Observability != nil || settings.TailConsumers != nil {
+		if err := updateWorkerScriptSettings(ctx, client, accountID, scriptData.Params.ScriptName, settings); err != nil {
+			return diag.FromErr(errors.Wrap(err, "error updating worker script settings"))
+		}
+	}
+
+	return workerScriptCompatibilityFlagsWarnings(d)
 }
 
 func resourceCloudflareWorkerScriptDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -367,6 +523,25 @@ func resourceCloudflareWorkerScriptDelete(ctx context.Context, d *schema.Resourc
 	return nil
 }
 
+// workerScriptCompatibilityFlagsWarnings warns about compatibility flags which
+// both enable and disable the same runtime behaviour. The runtime accepts them,
+// but which one wins is easy to get wrong.
+func workerScriptCompatibilityFlagsWarnings(d *schema.ResourceData) diag.Diagnostics {
+	flags := expandInterfaceToStringList(d.Get("compatibility_flags").(*schema.Set).List())
+
+	var diags diag.Diagnostics
+	for _, conflict := range conflictingWorkerScriptCompatibilityFlags(flags) {
+		diags = append(diags, diag.Diagnostic{
+			Severity:      diag.Warning,
+			Summary:       "Conflicting compatibility flags",
+			Detail:        fmt.Sprintf("compatibility_flags contains both %q and %q, which enable and disable the same runtime behaviour.", conflict[0], conflict[1]),
+			AttributePath: cty.GetAttrPath("compatibility_flags"),
+		})
+	}
+
+	return diags
+}
+
 func resourceCloudflareWorkerScriptImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
 	attributes := strings.SplitN(d.Id(), "/", 2)
 
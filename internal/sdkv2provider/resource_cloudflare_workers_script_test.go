@@ -4,12 +4,17 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"regexp"
 	"strings"
 	"testing"
+	"time"
 
+	"github.com/cloudflare/terraform-provider-cloudflare/internal/consts"
 	cloudflare "github.com/curtislarson/cloudflare-go"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
 )
 
 const (
@@ -91,6 +96,136 @@ func TestAccCloudflareWorkerScript_ModuleUpload(t *testing.T) {
 	})
 }
 
+func TestAccCloudflareWorkerScript_ObservabilityAndTailConsumers(t *testing.T) {
+	t.Parallel()
+
+	var script cloudflare.WorkerScript
+	rnd := generateRandomResourceName()
+	name := "cloudflare_worker_script." + rnd
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAccount(t)
+		},
+		ProviderFactories: providerFactories,
+		CheckDestroy:      testAccCheckCloudflareWorkerScriptDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckCloudflareWorkerScriptConfigObservability(rnd, accountID, "0.5"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCloudflareWorkerScriptExists(name, &script, nil),
+					resource.TestCheckResourceAttr(name, "observability.#", "1"),
+					resource.TestCheckResourceAttr(name, "observability.0.enabled", "true"),
+					resource.TestCheckResourceAttr(name, "observability.0.head_sampling_rate", "0.5"),
+					resource.TestCheckResourceAttr(name, "tail_consumers.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(name, "tail_consumers.*", map[string]string{
+						"service": rnd + "-tail",
+					}),
+				),
+			},
+			{
+				Config: testAccCheckCloudflareWorkerScriptConfigObservability(rnd, accountID, "1"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "observability.0.head_sampling_rate", "1"),
+				),
+			},
+			{
+				Config: testAccCheckCloudflareWorkerScriptConfigTailConsumers(rnd, accountID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "observability.#", "0"),
+					testAccCheckCloudflareWorkerScriptObservabilityDisabled(name),
+				),
+			},
+		},
+	})
+}
+
+func TestAccCloudflareWorkerScript_CompatibilitySettings(t *testing.T) {
+	t.Parallel()
+
+	var script cloudflare.WorkerScript
+	rnd := generateRandomResourceName()
+	name := "cloudflare_worker_script." + rnd
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAccount(t)
+		},
+		ProviderFactories: providerFactories,
+		CheckDestroy:      testAccCheckCloudflareWorkerScriptDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCheckCloudflareWorkerScriptConfigCompatibility(rnd, accountID, "2999-01-01", `"nodejs_compat"`),
+				ExpectError: regexp.MustCompile(`must not be in the future`),
+			},
+			{
+				Config: testAccCheckCloudflareWorkerScriptConfigCompatibility(rnd, accountID, "2023-01-01", `"nodejs_compat"`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCloudflareWorkerScriptExists(name, &script, nil),
+					resource.TestCheckResourceAttr(name, "compatibility_date", "2023-01-01"),
+					resource.TestCheckResourceAttr(name, "compatibility_flags.#", "1"),
+				),
+			},
+			{
+				Config: testAccCheckCloudflareWorkerScriptConfigCompatibility(rnd, accountID, "2023-06-01", `"nodejs_compat", "url_standard"`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "compatibility_date", "2023-06-01"),
+					resource.TestCheckResourceAttr(name, "compatibility_flags.#", "2"),
+					resource.TestCheckTypeSetElemAttr(name, "compatibility_flags.*", "url_standard"),
+				),
+			},
+		},
+	})
+}
+
+func TestValidateWorkerScriptCompatibilityDate(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]bool{
+		"2023-01-01":                          false,
+		time.Now().UTC().Format("2006-01-02"): false,
+		time.Now().UTC().AddDate(0, 0, 2).Format("2006-01-02"): true,
+		"2023-13-01": true,
+		"2023/01/01": true,
+		"":           true,
+	}
+
+	for value, expectErr := range testCases {
+		_, errs := validateWorkerScriptCompatibilityDate(value, "compatibility_date")
+		assert.Equal(t, expectErr, len(errs) > 0, "compatibility date %q", value)
+	}
+}
+
+func TestConflictingWorkerScriptCompatibilityFlags(t *testing.T) {
+	t.Parallel()
+
+	assert.Empty(t, conflictingWorkerScriptCompatibilityFlags([]string{"nodejs_compat", "streams_enable_constructors"}))
+	assert.Equal(t, [][2]string{{"nodejs_compat", "no_nodejs_compat"}}, conflictingWorkerScriptCompatibilityFlags([]string{"no_nodejs_compat", "nodejs_compat"}))
+	assert.Equal(t,
+		[][2]string{{"streams_enable_constructors", "streams_disable_constructors"}, {"url_standard", "url_original"}},
+		conflictingWorkerScriptCompatibilityFlags([]string{"url_original", "streams_enable_constructors", "url_standard", "streams_disable_constructors"}),
+	)
+}
+
+func TestWorkerScriptCompatibilityFlagsWarnings(t *testing.T) {
+	t.Parallel()
+
+	r := resourceCloudflareWorkerScript()
+
+	d := r.TestResourceData()
+	d.Set("compatibility_flags", []interface{}{"nodejs_compat", "url_standard"})
+	assert.Empty(t, workerScriptCompatibilityFlagsWarnings(d))
+
+	d.Set("compatibility_flags", []interface{}{"nodejs_compat", "no_nodejs_compat"})
+	diags := workerScriptCompatibilityFlagsWarnings(d)
+	if assert.Len(t, diags, 1) {
+		assert.Equal(t, diag.Warning, diags[0].Severity)
+		assert.Contains(t, diags[0].Detail, `"nodejs_compat" and "no_nodejs_compat"`)
+	}
+}
+
 // Create a bucket before creating a worker script binding.
 // When a cloudflare_r2_bucket resource is added, we can switch to that instead
 func testAccCheckCloudflareWorkerScriptCreateBucket(t *testing.T, rnd string) {
@@ -186,6 +321,86 @@ resource "cloudflare_worker_script" "%[1]s" {
 }`, rnd, moduleContent, accountID)
 }
 
+func testAccCheckCloudflareWorkerScriptObservabilityDisabled(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("not found: %s", n)
+		}
+
+		client := testAccProvider.Meta().(*cloudflare.API)
+		settings, err := getWorkerScriptSettings(context.Background(), client, rs.Primary.Attributes[consts.AccountIDSchemaKey], rs.Primary.Attributes["name"])
+		if err != nil {
+			return err
+		}
+
+		if settings.Observability != nil && settings.Observability.Enabled {
+			return fmt.Errorf("expected Workers Logs to be disabled for %s", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckCloudflareWorkerScriptConfigTailConsumers(rnd, accountID string) string {
+	return fmt.Sprintf(`
+resource "cloudflare_worker_script" "%[1]s_tail" {
+  account_id = "%[3]s"
+  name = "%[1]s-tail"
+  content = "%[2]s"
+  module = true
+}
+
+resource "cloudflare_worker_script" "%[1]s" {
+  account_id = "%[3]s"
+  name = "%[1]s"
+  content = "%[2]s"
+  module = true
+
+  tail_consumers {
+    service = cloudflare_worker_script.%[1]s_tail.name
+  }
+}`, rnd, moduleContent, accountID)
+}
+
+func testAccCheckCloudflareWorkerScriptConfigObservability(rnd, accountID, headSamplingRate string) string {
+	return fmt.Sprintf(`
+resource "cloudflare_worker_script" "%[1]s_tail" {
+  account_id = "%[3]s"
+  name = "%[1]s-tail"
+  content = "%[2]s"
+  module = true
+}
+
+resource "cloudflare_worker_script" "%[1]s" {
+  account_id = "%[3]s"
+  name = "%[1]s"
+  content = "%[2]s"
+  module = true
+
+  observability {
+    enabled = true
+    head_sampling_rate = %[4]s
+  }
+
+  tail_consumers {
+    service = cloudflare_worker_script.%[1]s_tail.name
+  }
+}`, rnd, moduleContent, accountID, headSamplingRate)
+}
+
+func testAccCheckCloudflareWorkerScriptConfigCompatibility(rnd, accountID, compatibilityDate, compatibilityFlags string) string {
+	return fmt.Sprintf(`
+resource "cloudflare_worker_script" "%[1]s" {
+  account_id = "%[3]s"
+  name = "%[1]s"
+  content = "%[2]s"
+  module = true
+  compatibility_date = "%[4]s"
+  compatibility_flags = [%[5]s]
+}`, rnd, moduleContent, accountID, compatibilityDate, compatibilityFlags)
+}
+
 func testAccCheckCloudflareWorkerScriptExists(n string, script *cloudflare.WorkerScript, bindings []string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		accountID := os.Getenv("CLOUDFLARE_ACCOUNT_ID")
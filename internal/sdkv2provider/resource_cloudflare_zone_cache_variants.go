@@ -19,7 +19,16 @@ func resourceCloudflareZoneCacheVariants() *schema.Resource {
 		ReadContext:   resourceCloudflareZoneCacheVariantsRead,
 		UpdateContext: resourceCloudflareZoneCacheVariantsUpdate,
 		DeleteContext: resourceCloudflareZoneCacheVariantsDelete,
-		Description:   "Provides a resource which customizes Cloudflare zone cache variants.",
+		CustomizeDiff: resourceCloudflareZoneCacheVariantsCustomizeDiff,
+		SchemaVersion: 1,
+		StateUpgraders: []schema.StateUpgrader{
+			{
+				Type:    resourceCloudflareZoneCacheVariantsV0().CoreConfigSchema().ImpliedType(),
+				Upgrade: resourceCloudflareZoneCacheVariantsStateUpgradeV1,
+				Version: 0,
+			},
+		},
+		Description: "Provides a resource which customizes Cloudflare zone cache variants.",
 	}
 }
 
@@ -37,7 +46,7 @@ func resourceCloudflareZoneCacheVariantsRead(ctx context.Context, d *schema.Reso
 			d.SetId("")
 			return nil
 		} else {
-			return diag.FromErr(fmt.Errorf("Error reading cache variants for zone %q: %w", d.Id(), err))
+			return diag.Diagnostics{cloudflareErrorDiagnostic(fmt.Sprintf("error reading cache variants for zone %q", d.Id()), err)}
 		}
 	}
 
@@ -102,7 +111,7 @@ func resourceCloudflareZoneCacheVariantsUpdate(ctx context.Context, d *schema.Re
 	_, err := client.UpdateZoneCacheVariants(ctx, d.Id(), variantsValue)
 
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("error setting cache variants for zone %q: %w", d.Id(), err))
+		return diag.Diagnostics{cloudflareErrorDiagnostic(fmt.Sprintf("error setting cache variants for zone %q", d.Id()), err)}
 	}
 
 	return resourceCloudflareZoneCacheVariantsRead(ctx, d, meta)
@@ -116,58 +125,66 @@ func resourceCloudflareZoneCacheVariantsDelete(ctx context.Context, d *schema.Re
 	err := client.DeleteZoneCacheVariants(ctx, d.Id())
 
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("error deleting cache variants for zone %v: %w", d.Id(), err))
+		return diag.Diagnostics{cloudflareErrorDiagnostic(fmt.Sprintf("error deleting cache variants for zone %q", d.Id()), err)}
 	}
 
 	return nil
 }
 
 func cacheVariantsValuesFromResource(d *schema.ResourceData) cloudflare.ZoneCacheVariantsValues {
-	variantsValue := cloudflare.ZoneCacheVariantsValues{}
-
-	if value, ok := d.GetOk("avif"); ok {
-		variantsValue.Avif = expandInterfaceToStringList(value.(*schema.Set).List())
-	}
-
-	if value, ok := d.GetOk("bmp"); ok {
-		variantsValue.Bmp = expandInterfaceToStringList(value.(*schema.Set).List())
-	}
-
-	if value, ok := d.GetOk("gif"); ok {
-		variantsValue.Gif = expandInterfaceToStringList(value.(*schema.Set).List())
-	}
-
-	if value, ok := d.GetOk("jpeg"); ok {
-		variantsValue.Jpeg = expandInterfaceToStringList(value.(*schema.Set).List())
+	return cloudflare.ZoneCacheVariantsValues{
+		Avif: cacheVariantsExtensionFromResource(d, "avif"),
+		Bmp:  cacheVariantsExtensionFromResource(d, "bmp"),
+		Gif:  cacheVariantsExtensionFromResource(d, "gif"),
+		Jpeg: cacheVariantsExtensionFromResource(d, "jpeg"),
+		Jpg:  cacheVariantsExtensionFromResource(d, "jpg"),
+		Jp2:  cacheVariantsExtensionFromResource(d, "jp2"),
+		Jpg2: cacheVariantsExtensionFromResource(d, "jpg2"),
+		Png:  cacheVariantsExtensionFromResource(d, "png"),
+		Tif:  cacheVariantsExtensionFromResource(d, "tif"),
+		Tiff: cacheVariantsExtensionFromResource(d, "tiff"),
+		Webp: cacheVariantsExtensionFromResource(d, "webp"),
 	}
+}
 
-	if value, ok := d.GetOk("jpg"); ok {
-		variantsValue.Jpg = expandInterfaceToStringList(value.(*schema.Set).List())
+// cacheVariantsExtensionFromResource returns the configured variants for the
+// extension, falling back to the recommended ones when enabled.
+func cacheVariantsExtensionFromResource(d *schema.ResourceData, ext string) []string {
+	if value, ok := d.GetOk(ext); ok {
+		return expandInterfaceToStringList(value.(*schema.Set).List())
 	}
 
-	if value, ok := d.GetOk("jp2"); ok {
-		variantsValue.Jp2 = expandInterfaceToStringList(value.(*schema.Set).List())
+	if d.Get("use_recommended_defaults").(bool) {
+		return zoneCacheVariantsRecommendedDefaults[ext]
 	}
 
-	if value, ok := d.GetOk("jpg2"); ok {
-		variantsValue.Jpg2 = expandInterfaceToStringList(value.(*schema.Set).List())
-	}
+	return nil
+}
 
-	if value, ok := d.GetOk("png"); ok {
-		variantsValue.Png = expandInterfaceToStringList(value.(*schema.Set).List())
+// resourceCloudflareZoneCacheVariantsCustomizeDiff plans the variants of each
+// extension left out of the configuration: the recommended ones when
+// `use_recommended_defaults` is enabled, otherwise none.
+func resourceCloudflareZoneCacheVariantsCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	config := d.GetRawConfig()
+	if config.IsNull() || !config.IsKnown() {
+		return nil
 	}
 
-	if value, ok := d.GetOk("tif"); ok {
-		variantsValue.Tif = expandInterfaceToStringList(value.(*schema.Set).List())
-	}
+	useDefaults := d.Get("use_recommended_defaults").(bool)
+	for _, ext := range zoneCacheVariantsExtensions {
+		if !config.GetAttr(ext).IsNull() {
+			continue
+		}
 
-	if value, ok := d.GetOk("tiff"); ok {
-		variantsValue.Tiff = expandInterfaceToStringList(value.(*schema.Set).List())
-	}
+		var variants []string
+		if useDefaults {
+			variants = zoneCacheVariantsRecommendedDefaults[ext]
+		}
 
-	if value, ok := d.GetOk("webp"); ok {
-		variantsValue.Webp = expandInterfaceToStringList(value.(*schema.Set).List())
+		if err := d.SetNew(ext, variants); err != nil {
+			return fmt.Errorf("failed to set %s: %w", ext, err)
+		}
 	}
 
-	return variantsValue
+	return nil
 }
@@ -0,0 +1,75 @@
+package sdkv2provider
+
+import (
+	"context"
+	"strings"
+
+	"github.com/cloudflare/terraform-provider-cloudflare/internal/consts"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// zoneCacheVariantsExtensionsV0 are the extensions of the v0 schema. They're
+// listed separately so later changes to the extensions can't alter how the
+// legacy state is decoded.
+var zoneCacheVariantsExtensionsV0 = []string{"avif", "bmp", "gif", "jpeg", "jpg", "jpg2", "jp2", "png", "tiff", "tif", "webp"}
+
+func resourceCloudflareZoneCacheVariantsV0() *schema.Resource {
+	s := map[string]*schema.Schema{
+		consts.ZoneIDSchemaKey: {
+			Type:     schema.TypeString,
+			Required: true,
+			ForceNew: true,
+		},
+	}
+
+	for _, ext := range zoneCacheVariantsExtensionsV0 {
+		s[ext] = &schema.Schema{
+			Type:     schema.TypeSet,
+			MinItems: 1,
+			Optional: true,
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		}
+	}
+
+	return &schema.Resource{Schema: s}
+}
+
+// resourceCloudflareZoneCacheVariantsStateUpgradeV1 cleans up the variants of
+// each extension. Legacy state may hold duplicate or empty entries, or an
+// empty list which the set's minimum of one item rejects.
+func resourceCloudflareZoneCacheVariantsStateUpgradeV1(_ context.Context, rawState map[string]interface{}, meta interface{}) (map[string]interface{}, error) {
+	if rawState == nil {
+		return rawState, nil
+	}
+
+	for _, ext := range zoneCacheVariantsExtensionsV0 {
+		raw, ok := rawState[ext].([]interface{})
+		if !ok {
+			delete(rawState, ext)
+			continue
+		}
+
+		seen := make(map[string]bool)
+		variants := make([]interface{}, 0, len(raw))
+		for _, item := range raw {
+			value, _ := item.(string)
+			value = strings.TrimSpace(value)
+			if value == "" || seen[value] {
+				continue
+			}
+			seen[value] = true
+			variants = append(variants, value)
+		}
+
+		if len(variants) == 0 {
+			delete(rawState, ext)
+			continue
+		}
+
+		rawState[ext] = variants
+	}
+
+	return rawState, nil
+}
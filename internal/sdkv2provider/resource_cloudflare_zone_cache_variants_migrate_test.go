@@ -0,0 +1,105 @@
+package sdkv2provider
+
+import (
+	"context"
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	ctyjson "github.com/hashicorp/go-cty/cty/json"
+)
+
+func testCloudflareZoneCacheVariantsDataV0() map[string]interface{} {
+	return map[string]interface{}{
+		"id":      "0da42c8d2132a9ddaf714f9e7c920711",
+		"zone_id": "0da42c8d2132a9ddaf714f9e7c920711",
+		"avif":    []interface{}{"image/avif", "image/webp", "image/avif"},
+		"jpeg":    []interface{}{"image/jpeg", " image/webp"},
+		"png":     []interface{}{},
+		"webp":    []interface{}{"", "image/webp"},
+		"gif":     nil,
+	}
+}
+
+func testCloudflareZoneCacheVariantsDataV1() map[string]interface{} {
+	return map[string]interface{}{
+		"id":      "0da42c8d2132a9ddaf714f9e7c920711",
+		"zone_id": "0da42c8d2132a9ddaf714f9e7c920711",
+		"avif":    []interface{}{"image/avif", "image/webp"},
+		"jpeg":    []interface{}{"image/jpeg", "image/webp"},
+		"webp":    []interface{}{"image/webp"},
+	}
+}
+
+func TestCloudflareZoneCacheVariantsUpgradeV0(t *testing.T) {
+	expected := testCloudflareZoneCacheVariantsDataV1()
+	actual, err := resourceCloudflareZoneCacheVariantsStateUpgradeV1(context.TODO(), testCloudflareZoneCacheVariantsDataV0(), nil)
+	if err != nil {
+		t.Fatalf("error migrating state: %s", err)
+	}
+
+	if !reflect.DeepEqual(expected, actual) {
+		t.Fatalf("\n\nexpected:\n\n%#v\n\ngot:\n\n%#v\n\n", expected, actual)
+	}
+}
+
+// testCloudflareZoneCacheVariantsStateV0 is the state of a resource written
+// by a provider release using the v0 schema.
+const testCloudflareZoneCacheVariantsStateV0 = `{
+	"avif": ["image/avif", "image/webp"],
+	"bmp": null,
+	"gif": [],
+	"id": "0da42c8d2132a9ddaf714f9e7c920711",
+	"jp2": ["image/jp2", "image/webp"],
+	"jpeg": ["image/jpeg", "image/webp", " image/webp"],
+	"jpg": ["image/jpg", "image/webp"],
+	"jpg2": [""],
+	"png": ["image/png"],
+	"tif": null,
+	"tiff": null,
+	"webp": ["image/webp"],
+	"zone_id": "0da42c8d2132a9ddaf714f9e7c920711"
+}`
+
+func TestCloudflareZoneCacheVariantsUpgradeV0LegacyState(t *testing.T) {
+	// The legacy state must decode with the v0 schema, as Terraform does
+	// before running the upgrader.
+	_, err := ctyjson.Unmarshal([]byte(testCloudflareZoneCacheVariantsStateV0), resourceCloudflareZoneCacheVariantsV0().CoreConfigSchema().ImpliedType())
+	if err != nil {
+		t.Fatalf("error decoding v0 state: %s", err)
+	}
+
+	var rawState map[string]interface{}
+	if err := json.Unmarshal([]byte(testCloudflareZoneCacheVariantsStateV0), &rawState); err != nil {
+		t.Fatalf("error unmarshalling v0 state: %s", err)
+	}
+
+	upgraded, err := resourceCloudflareZoneCacheVariantsStateUpgradeV1(context.TODO(), rawState, nil)
+	if err != nil {
+		t.Fatalf("error migrating state: %s", err)
+	}
+
+	expected := map[string]interface{}{
+		"avif":    []interface{}{"image/avif", "image/webp"},
+		"id":      "0da42c8d2132a9ddaf714f9e7c920711",
+		"jp2":     []interface{}{"image/jp2", "image/webp"},
+		"jpeg":    []interface{}{"image/jpeg", "image/webp"},
+		"jpg":     []interface{}{"image/jpg", "image/webp"},
+		"png":     []interface{}{"image/png"},
+		"webp":    []interface{}{"image/webp"},
+		"zone_id": "0da42c8d2132a9ddaf714f9e7c920711",
+	}
+	if !reflect.DeepEqual(expected, upgraded) {
+		t.Fatalf("\n\nexpected:\n\n%#v\n\ngot:\n\n%#v\n\n", expected, upgraded)
+	}
+
+	// The upgraded state must decode with the current schema.
+	upgradedJSON, err := json.Marshal(upgraded)
+	if err != nil {
+		t.Fatalf("error marshalling upgraded state: %s", err)
+	}
+
+	if _, err := ctyjson.Unmarshal(upgradedJSON, resourceCloudflareZoneCacheVariants().CoreConfigSchema().ImpliedType()); err != nil {
+		t.Fatalf("error decoding upgraded state: %s", err)
+	}
+}
@@ -8,6 +8,8 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
 )
 
 func init() {
@@ -116,6 +118,72 @@ func TestAccCloudflareZoneCacheVariants_AllExt(t *testing.T) {
 	})
 }
 
+func TestAccCloudflareZoneCacheVariants_RecommendedDefaults(t *testing.T) {
+	zoneID := os.Getenv("CLOUDFLARE_ZONE_ID")
+	rnd := generateRandomResourceName()
+	name := fmt.Sprintf("cloudflare_zone_cache_variants.%s", rnd)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareZoneCacheVariants_RecommendedDefaults(zoneID, rnd, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "zone_id", zoneID),
+					resource.TestCheckResourceAttr(name, "use_recommended_defaults", "true"),
+					resource.TestCheckResourceAttr(name, "avif.#", "2"),
+					resource.TestCheckTypeSetElemAttr(name, "avif.*", "image/avif"),
+					resource.TestCheckTypeSetElemAttr(name, "avif.*", "image/webp"),
+					resource.TestCheckResourceAttr(name, "jpg.#", "2"),
+					resource.TestCheckTypeSetElemAttr(name, "jpg.*", "image/jpeg"),
+					resource.TestCheckTypeSetElemAttr(name, "jpg.*", "image/webp"),
+					resource.TestCheckResourceAttr(name, "jp2.#", "0"),
+					resource.TestCheckResourceAttr(name, "png.#", "1"),
+					resource.TestCheckTypeSetElemAttr(name, "png.*", "image/png"),
+					resource.TestCheckResourceAttr(name, "webp.#", "2"),
+					resource.TestCheckTypeSetElemAttr(name, "webp.*", "image/jpeg"),
+					resource.TestCheckTypeSetElemAttr(name, "webp.*", "image/webp"),
+				),
+			},
+			{
+				Config: testAccCloudflareZoneCacheVariants_RecommendedDefaults(zoneID, rnd, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "use_recommended_defaults", "false"),
+					resource.TestCheckResourceAttr(name, "avif.#", "0"),
+					resource.TestCheckResourceAttr(name, "png.#", "1"),
+					resource.TestCheckTypeSetElemAttr(name, "png.*", "image/png"),
+					resource.TestCheckResourceAttr(name, "webp.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestCacheVariantsValuesFromResource(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, resourceCloudflareZoneCacheVariantsSchema(), map[string]interface{}{
+		"zone_id":                  "0da42c8d2132a9ddaf714f9e7c920711",
+		"use_recommended_defaults": true,
+		"png":                      []interface{}{"image/png"},
+	})
+
+	values := cacheVariantsValuesFromResource(d)
+
+	assert.Equal(t, []string{"image/png"}, values.Png)
+	assert.Equal(t, []string{"image/jpeg", "image/webp"}, values.Webp)
+	assert.Equal(t, []string{"image/avif", "image/webp"}, values.Avif)
+
+	d = schema.TestResourceDataRaw(t, resourceCloudflareZoneCacheVariantsSchema(), map[string]interface{}{
+		"zone_id": "0da42c8d2132a9ddaf714f9e7c920711",
+		"png":     []interface{}{"image/png"},
+	})
+
+	values = cacheVariantsValuesFromResource(d)
+
+	assert.Equal(t, []string{"image/png"}, values.Png)
+	assert.Nil(t, values.Webp)
+}
+
 func testAccCloudflareZoneCacheVariants_OneExt(zoneID, name string) string {
 	return fmt.Sprintf(`
 		resource "cloudflare_zone_cache_variants" "%[2]s" {
@@ -141,3 +209,12 @@ func testAccCloudflareZoneCacheVariants_AllExt(zoneID, name string) string {
 			webp = ["image/webp"]
 		}`, zoneID, name)
 }
+
+func testAccCloudflareZoneCacheVariants_RecommendedDefaults(zoneID, name string, useDefaults bool) string {
+	return fmt.Sprintf(`
+		resource "cloudflare_zone_cache_variants" "%[2]s" {
+			zone_id = "%[1]s"
+			use_recommended_defaults = %[3]t
+			png = ["image/png"]
+		}`, zoneID, name, useDefaults)
+}
@@ -0,0 +1,156 @@
+package sdkv2provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/cloudflare/terraform-provider-cloudflare/internal/consts"
+	cloudflare "github.com/curtislarson/cloudflare-go"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// zoneSettingToggle describes a single on/off zone setting that is exposed as
+// its own resource instead of only through cloudflare_zone_settings_override.
+type zoneSettingToggle struct {
+	// ID is the identifier of the setting in the zone settings API.
+	ID string
+	// Title is the human readable name of the setting used in messages.
+	Title string
+	// Default is the value the setting is reset to when the resource is
+	// destroyed.
+	Default     string
+	Description string
+}
+
+func resourceCloudflareSortQueryStringForCache() *schema.Resource {
+	return resourceCloudflareZoneSettingToggle(zoneSettingToggle{
+		ID:      "sort_query_string_for_cache",
+		Title:   "Sort Query String for Cache",
+		Default: "off",
+		Description: heredoc.Doc(`
+			Provides a resource which manages the Sort Query String for Cache
+			setting of a zone. When enabled, Cloudflare treats files with the
+			same query strings as the same file in cache, regardless of the
+			order of the query strings.
+		`),
+	})
+}
+
+func resourceCloudflareOriginCacheControl() *schema.Resource {
+	return resourceCloudflareZoneSettingToggle(zoneSettingToggle{
+		ID:      "origin_cache_control",
+		Title:   "Origin Cache Control",
+		Default: "on",
+		Description: heredoc.Doc(`
+			Provides a resource which manages the Origin Cache Control setting
+			of a zone. When enabled, Cloudflare follows the ` + "`Cache-Control`" + `
+			directives sent by your origin more closely.
+		`),
+	})
+}
+
+func resourceCloudflareOriginErrorPagePassThru() *schema.Resource {
+	return resourceCloudflareZoneSettingToggle(zoneSettingToggle{
+		ID:      "origin_error_page_pass_thru",
+		Title:   "Origin Error Page Pass-thru",
+		Default: "off",
+		Description: heredoc.Doc(`
+			Provides a resource which manages the Origin Error Page Pass-thru
+			setting of a zone. When enabled, Cloudflare serves the error pages
+			of your origin for 502 and 504 errors instead of its own.
+		`),
+	})
+}
+
+func resourceCloudflareZoneSettingToggle(setting zoneSettingToggle) *schema.Resource {
+	return &schema.Resource{
+		Schema:        resourceCloudflareZoneSettingToggleSchema(setting.Title),
+		CreateContext: resourceCloudflareZoneSettingToggleUpdate(setting),
+		ReadContext:   resourceCloudflareZoneSettingToggleRead(setting),
+		UpdateContext: resourceCloudflareZoneSettingToggleUpdate(setting),
+		DeleteContext: resourceCloudflareZoneSettingToggleDelete(setting),
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceCloudflareZoneSettingToggleImport(setting),
+		},
+		Description: setting.Description,
+	}
+}
+
+func resourceCloudflareZoneSettingToggleRead(setting zoneSettingToggle) schema.ReadContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		client := meta.(*cloudflare.API)
+
+		tflog.Info(ctx, fmt.Sprintf("Reading %s in zone %q", setting.Title, d.Id()))
+
+		zoneSetting, err := client.ZoneSingleSetting(ctx, d.Id(), setting.ID)
+		if err != nil {
+			var notFoundError *cloudflare.NotFoundError
+			if errors.As(err, &notFoundError) {
+				tflog.Info(ctx, fmt.Sprintf("%s for zone %q not found", setting.Title, d.Id()))
+				d.SetId("")
+				return nil
+			}
+			return diag.FromErr(fmt.Errorf("error reading %s for zone %q: %w", setting.ID, d.Id(), err))
+		}
+
+		value, ok := zoneSetting.Value.(string)
+		if !ok {
+			return diag.FromErr(fmt.Errorf("unexpected value %v for %s in zone %q", zoneSetting.Value, setting.ID, d.Id()))
+		}
+
+		d.Set(consts.ZoneIDSchemaKey, d.Id())
+		d.Set("value", value)
+
+		return nil
+	}
+}
+
+func resourceCloudflareZoneSettingToggleUpdate(setting zoneSettingToggle) func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics {
+	return func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		client := meta.(*cloudflare.API)
+
+		zoneID := d.Get(consts.ZoneIDSchemaKey).(string)
+		d.SetId(zoneID)
+
+		value := d.Get("value").(string)
+		tflog.Info(ctx, fmt.Sprintf("Setting %s to %q for zone ID: %q", setting.Title, value, d.Id()))
+
+		_, err := client.UpdateZoneSingleSetting(ctx, d.Id(), setting.ID, cloudflare.ZoneSetting{Value: value})
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("error setting %s for zone %q: %w", setting.ID, d.Id(), err))
+		}
+
+		return resourceCloudflareZoneSettingToggleRead(setting)(ctx, d, meta)
+	}
+}
+
+func resourceCloudflareZoneSettingToggleDelete(setting zoneSettingToggle) schema.DeleteContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		client := meta.(*cloudflare.API)
+
+		tflog.Info(ctx, fmt.Sprintf("Resetting %s to %q for zone ID: %q", setting.Title, setting.Default, d.Id()))
+
+		_, err := client.UpdateZoneSingleSetting(ctx, d.Id(), setting.ID, cloudflare.ZoneSetting{Value: setting.Default})
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("error resetting %s for zone %q: %w", setting.ID, d.Id(), err))
+		}
+
+		return nil
+	}
+}
+
+func resourceCloudflareZoneSettingToggleImport(setting zoneSettingToggle) schema.StateContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+		d.Set(consts.ZoneIDSchemaKey, d.Id())
+
+		if diags := resourceCloudflareZoneSettingToggleRead(setting)(ctx, d, meta); diags.HasError() {
+			return nil, fmt.Errorf("failed to import %s for zone %q", setting.ID, d.Id())
+		}
+
+		return []*schema.ResourceData{d}, nil
+	}
+}
@@ -0,0 +1,89 @@
+package sdkv2provider
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	cloudflare "github.com/curtislarson/cloudflare-go"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func testZoneSettingToggleConfig(resourceType, rnd, zoneID, value string) string {
+	return fmt.Sprintf(`
+resource "%[1]s" "%[2]s" {
+	zone_id = "%[3]s"
+	value   = "%[4]s"
+}
+`, resourceType, rnd, zoneID, value)
+}
+
+func testAccCloudflareZoneSettingToggle(t *testing.T, resourceType, settingID, defaultValue string) {
+	rnd := generateRandomResourceName()
+	name := resourceType + "." + rnd
+	zoneID := os.Getenv("CLOUDFLARE_ZONE_ID")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		CheckDestroy:      testAccCheckCloudflareZoneSettingToggleDestroy(resourceType, settingID, defaultValue),
+		Steps: []resource.TestStep{
+			{
+				Config: testZoneSettingToggleConfig(resourceType, rnd, zoneID, "on"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "zone_id", zoneID),
+					resource.TestCheckResourceAttr(name, "value", "on"),
+				),
+			},
+			{
+				Config: testZoneSettingToggleConfig(resourceType, rnd, zoneID, "off"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "zone_id", zoneID),
+					resource.TestCheckResourceAttr(name, "value", "off"),
+				),
+			},
+			{
+				ResourceName:      name,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckCloudflareZoneSettingToggleDestroy(resourceType, settingID, defaultValue string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := testAccProvider.Meta().(*cloudflare.API)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != resourceType {
+				continue
+			}
+
+			setting, err := client.ZoneSingleSetting(context.Background(), rs.Primary.Attributes["zone_id"], settingID)
+			if err != nil {
+				return err
+			}
+
+			if setting.Value != defaultValue {
+				return fmt.Errorf("expected %s to be reset to %s, got: %v", settingID, defaultValue, setting.Value)
+			}
+		}
+
+		return nil
+	}
+}
+
+func TestAccCloudflareSortQueryStringForCache_Basic(t *testing.T) {
+	testAccCloudflareZoneSettingToggle(t, "cloudflare_sort_query_string_for_cache", "sort_query_string_for_cache", "off")
+}
+
+func TestAccCloudflareOriginCacheControl_Basic(t *testing.T) {
+	testAccCloudflareZoneSettingToggle(t, "cloudflare_origin_cache_control", "origin_cache_control", "on")
+}
+
+func TestAccCloudflareOriginErrorPagePassThru_Basic(t *testing.T) {
+	testAccCloudflareZoneSettingToggle(t, "cloudflare_origin_error_page_pass_thru", "origin_error_page_pass_thru", "off")
+}
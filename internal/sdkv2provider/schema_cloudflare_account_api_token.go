@@ -0,0 +1,59 @@
+package sdkv2provider
+
+import (
+	"fmt"
+
+	"github.com/cloudflare/terraform-provider-cloudflare/internal/consts"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+var accountAPITokenStatuses = []string{"active", "disabled"}
+
+func resourceCloudflareAccountAPITokenSchema() map[string]*schema.Schema {
+	p := resourceCloudflareApiTokenPolicySchema()
+
+	return map[string]*schema.Schema{
+		consts.AccountIDSchemaKey: {
+			Description: "The account identifier to target for the resource.",
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+		},
+		"name": {
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "Name of the API Token.",
+		},
+		"policies": {
+			Type:        schema.TypeSet,
+			Set:         schema.HashResource(p),
+			Required:    true,
+			Elem:        p,
+			Description: "Permissions policy. Multiple policies blocks can be defined. Only account and zone scoped permission groups may be used.",
+		},
+		"status": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Default:      "active",
+			ValidateFunc: validation.StringInSlice(accountAPITokenStatuses, false),
+			Description:  fmt.Sprintf("Status of the API Token. %s", renderAvailableDocumentationValuesStringSlice(accountAPITokenStatuses)),
+		},
+		"value": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Sensitive:   true,
+			Description: "The value of the API Token. Only available in the state of the resource that created the token.",
+		},
+		"issued_on": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Timestamp of when the token was issued.",
+		},
+		"modified_on": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Timestamp of when the token was last modified.",
+		},
+	}
+}
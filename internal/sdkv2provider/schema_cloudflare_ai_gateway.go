@@ -0,0 +1,70 @@
+package sdkv2provider
+
+import (
+	"fmt"
+	"regexp"
+
+	"github.com/cloudflare/terraform-provider-cloudflare/internal/consts"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+var aiGatewayRateLimitingTechniques = []string{"fixed", "sliding"}
+
+func resourceCloudflareAIGatewaySchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		consts.AccountIDSchemaKey: {
+			Description: "The account identifier to target for the resource.",
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+		},
+		"name": {
+			Type:         schema.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringMatch(regexp.MustCompile(`^[a-z0-9_]+(?:-[a-z0-9_]+)*$`), "must only contain lowercase letters, numbers, underscores and single hyphens"),
+			Description:  "The name of the gateway, used as its identifier in the gateway URL.",
+		},
+		"cache_ttl": {
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Default:      0,
+			ValidateFunc: validation.IntBetween(0, 2592000),
+			Description:  "Number of seconds responses are cached for. Must be between `0` and `2592000` (30 days); `0` disables caching.",
+		},
+		"cache_invalidate_on_update": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+			Description: "Whether cached responses are invalidated when the gateway is updated.",
+		},
+		"collect_logs": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     true,
+			Description: "Whether requests proxied through the gateway are logged.",
+		},
+		"rate_limiting_interval": {
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Default:      0,
+			ValidateFunc: validation.IntBetween(0, 3600),
+			Description:  "Length in seconds of the rate limiting window. Must be between `0` and `3600`; `0` disables rate limiting.",
+		},
+		"rate_limiting_limit": {
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Default:      0,
+			ValidateFunc: validation.IntAtLeast(0),
+			Description:  "Number of requests allowed within each rate limiting window.",
+		},
+		"rate_limiting_technique": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Default:      "fixed",
+			ValidateFunc: validation.StringInSlice(aiGatewayRateLimitingTechniques, false),
+			Description:  fmt.Sprintf("Technique used to count requests within the rate limiting window. %s", renderAvailableDocumentationValuesStringSlice(aiGatewayRateLimitingTechniques)),
+		},
+	}
+}
@@ -0,0 +1,67 @@
+package sdkv2provider
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/cloudflare/terraform-provider-cloudflare/internal/consts"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"gopkg.in/yaml.v3"
+)
+
+func resourceCloudflareAPIShieldSchemaSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		consts.ZoneIDSchemaKey: {
+			Description: "The zone identifier to target for the resource.",
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+		},
+		"name": {
+			Description: "Name of the schema.",
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+		},
+		"kind": {
+			Description:  fmt.Sprintf("Kind of schema. %s", renderAvailableDocumentationValuesStringSlice([]string{"openapi_v3"})),
+			Type:         schema.TypeString,
+			Optional:     true,
+			ForceNew:     true,
+			Default:      "openapi_v3",
+			ValidateFunc: validation.StringInSlice([]string{"openapi_v3"}, false),
+		},
+		"source": {
+			Description:  "Schema file bytes in JSON or YAML format.",
+			Type:         schema.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validateAPIShieldSchemaSource,
+		},
+		"validation_enabled": {
+			Description: "Flag whether schema is enabled for validation.",
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+		},
+	}
+}
+
+// validateAPIShieldSchemaSource ensures the schema source is a JSON or YAML
+// document before it is uploaded.
+func validateAPIShieldSchemaSource(v interface{}, k string) (ws []string, errs []error) {
+	var doc interface{}
+	if err := json.Unmarshal([]byte(v.(string)), &doc); err != nil {
+		if err := yaml.Unmarshal([]byte(v.(string)), &doc); err != nil {
+			errs = append(errs, fmt.Errorf("%q must be a valid JSON or YAML document: %w", k, err))
+			return
+		}
+	}
+
+	if _, ok := doc.(map[string]interface{}); !ok {
+		errs = append(errs, fmt.Errorf("%q must be a JSON or YAML object", k))
+	}
+
+	return
+}
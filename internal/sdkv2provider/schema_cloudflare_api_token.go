@@ -7,8 +7,10 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
-func resourceCloudflareApiTokenSchema() map[string]*schema.Schema {
-	p := schema.Resource{
+// resourceCloudflareApiTokenPolicySchema is the schema of a single API token
+// permissions policy.
+func resourceCloudflareApiTokenPolicySchema() *schema.Resource {
+	return &schema.Resource{
 		Schema: map[string]*schema.Schema{
 			"resources": {
 				Type:        schema.TypeMap,
@@ -31,6 +33,10 @@ func resourceCloudflareApiTokenSchema() map[string]*schema.Schema {
 			},
 		},
 	}
+}
+
+func resourceCloudflareApiTokenSchema() map[string]*schema.Schema {
+	p := resourceCloudflareApiTokenPolicySchema()
 
 	return map[string]*schema.Schema{
 		"name": {
@@ -40,9 +46,9 @@ func resourceCloudflareApiTokenSchema() map[string]*schema.Schema {
 		},
 		"policy": {
 			Type:        schema.TypeSet,
-			Set:         schema.HashResource(&p),
+			Set:         schema.HashResource(p),
 			Required:    true,
-			Elem:        &p,
+			Elem:        p,
 			Description: "Permissions policy. Multiple policy blocks can be defined.",
 		},
 		"condition": {
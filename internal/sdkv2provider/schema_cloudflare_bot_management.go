@@ -0,0 +1,70 @@
+package sdkv2provider
+
+import (
+	"fmt"
+
+	"github.com/cloudflare/terraform-provider-cloudflare/internal/consts"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+var (
+	botManagementSBFMActions             = []string{"allow", "block", "managed_challenge"}
+	botManagementSBFMVerifiedBotsActions = []string{"allow", "block"}
+)
+
+func resourceCloudflareBotManagementSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		consts.ZoneIDSchemaKey: {
+			Description: "The zone identifier to target for the resource.",
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+		},
+		"enable_js": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Computed:    true,
+			Description: "Use lightweight, invisible JavaScript detections to improve Bot Management.",
+		},
+		"fight_mode": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Computed:    true,
+			Description: "Whether to enable Bot Fight Mode.",
+		},
+		"sbfm_definitely_automated": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Computed:     true,
+			ValidateFunc: validation.StringInSlice(botManagementSBFMActions, false),
+			Description:  fmt.Sprintf("Super Bot Fight Mode (SBFM) action to take on definitely automated requests. %s", renderAvailableDocumentationValuesStringSlice(botManagementSBFMActions)),
+		},
+		"sbfm_likely_automated": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Computed:     true,
+			ValidateFunc: validation.StringInSlice(botManagementSBFMActions, false),
+			Description:  fmt.Sprintf("Super Bot Fight Mode (SBFM) action to take on likely automated requests. %s", renderAvailableDocumentationValuesStringSlice(botManagementSBFMActions)),
+		},
+		"sbfm_verified_bots": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Computed:     true,
+			ValidateFunc: validation.StringInSlice(botManagementSBFMVerifiedBotsActions, false),
+			Description:  fmt.Sprintf("Super Bot Fight Mode (SBFM) action to take on verified bots requests. %s", renderAvailableDocumentationValuesStringSlice(botManagementSBFMVerifiedBotsActions)),
+		},
+		"sbfm_static_resource_protection": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Computed:    true,
+			Description: "Super Bot Fight Mode (SBFM) to enable static resource protection. Enable if static resources on your application need bot protection. Note: Static resource protection can also result in legitimate traffic being blocked.",
+		},
+		"optimize_wordpress": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Computed:    true,
+			Description: "Whether to optimize Super Bot Fight Mode protections for Wordpress.",
+		},
+	}
+}
@@ -0,0 +1,77 @@
+package sdkv2provider
+
+import (
+	"fmt"
+
+	"github.com/cloudflare/terraform-provider-cloudflare/internal/consts"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+var dnsRecordsBatchTypes = []string{"A", "AAAA", "CAA", "CNAME", "TXT", "SRV", "MX", "NS", "SPF", "PTR", "HTTPS", "URI"}
+
+func resourceCloudflareDNSRecordsBatchSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		consts.ZoneIDSchemaKey: {
+			Description: "The zone identifier to target for the resource.",
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+		},
+		"record": {
+			Type:        schema.TypeList,
+			Required:    true,
+			MinItems:    1,
+			Description: "DNS records managed by the batch. All changes to these records are submitted as a single atomic request.",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"id": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "The identifier of the DNS record.",
+					},
+					"name": {
+						Type:        schema.TypeString,
+						Required:    true,
+						Description: "The name of the record.",
+					},
+					"type": {
+						Type:         schema.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringInSlice(dnsRecordsBatchTypes, false),
+						Description:  fmt.Sprintf("The type of the record. %s", renderAvailableDocumentationValuesStringSlice(dnsRecordsBatchTypes)),
+					},
+					"value": {
+						Type:             schema.TypeString,
+						Required:         true,
+						DiffSuppressFunc: suppressDNSRecordsBatchEquivalentContent,
+						Description:      "The value of the record.",
+					},
+					"ttl": {
+						Type:        schema.TypeInt,
+						Optional:    true,
+						Computed:    true,
+						Description: "The TTL of the record. Must be set to `1` when `proxied` is `true`.",
+					},
+					"proxied": {
+						Type:        schema.TypeBool,
+						Optional:    true,
+						Default:     false,
+						Description: "Whether the record gets Cloudflare's origin protection.",
+					},
+					"priority": {
+						Type:         schema.TypeInt,
+						Optional:     true,
+						ValidateFunc: validation.IntBetween(0, 65535),
+						Description:  "The priority of the record. Used by `MX`, `SRV` and `URI` records.",
+					},
+					"comment": {
+						Type:        schema.TypeString,
+						Optional:    true,
+						Description: "Comments or notes about the DNS record. This field has no effect on DNS responses.",
+					},
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,63 @@
+package sdkv2provider
+
+import (
+	"github.com/cloudflare/terraform-provider-cloudflare/internal/consts"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceCloudflareMTLSCertificateSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		consts.AccountIDSchemaKey: {
+			Description: "The account identifier to target for the resource.",
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+		},
+		"name": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			ForceNew:    true,
+			Description: "Optional unique name for the certificate.",
+		},
+		"certificates": {
+			Type:             schema.TypeString,
+			Required:         true,
+			ForceNew:         true,
+			DiffSuppressFunc: suppressEquivalentMTLSCertificatePEM,
+			Description:      "Certificate you intend to use with mTLS-enabled services.",
+		},
+		"private_key": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Sensitive:   true,
+			ForceNew:    true,
+			Description: "The certificate's private key. Required when the certificate is associated with a hostname.",
+		},
+		"ca": {
+			Type:        schema.TypeBool,
+			Required:    true,
+			ForceNew:    true,
+			Description: "Whether this is a CA or leaf certificate.",
+		},
+		"issuer": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"signature": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"serial_number": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"uploaded_on": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"expires_on": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+	}
+}
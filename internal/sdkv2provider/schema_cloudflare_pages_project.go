@@ -33,6 +33,7 @@ func resourceCloudflarePagesProjectSchema() map[string]*schema.Schema {
 				Type:        schema.TypeString,
 				Description: "The auth token for analytics.",
 				Optional:    true,
+				Sensitive:   true,
 			},
 		},
 	}
@@ -120,6 +121,14 @@ func resourceCloudflarePagesProjectSchema() map[string]*schema.Schema {
 				Description: "Environment variables for Pages Functions.",
 				Optional:    true,
 			},
+			"secrets": {
+				Type:             schema.TypeMap,
+				Description:      "Encrypted environment variables for Pages Functions. The values are write-only; only a hash of each value is stored in state to detect changes.",
+				Optional:         true,
+				Sensitive:        true,
+				Elem:             &schema.Schema{Type: schema.TypeString},
+				DiffSuppressFunc: suppressPagesProjectSecretHash,
+			},
 			"kv_namespaces": {
 				Type:        schema.TypeMap,
 				Description: "KV namespaces used for Pages Functions.",
@@ -0,0 +1,75 @@
+package sdkv2provider
+
+import (
+	"fmt"
+
+	"github.com/cloudflare/terraform-provider-cloudflare/internal/consts"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+var r2BucketEventNotificationActions = []string{"PutObject", "CopyObject", "DeleteObject", "CompleteMultipartUpload", "LifecycleDeletion"}
+
+func resourceCloudflareR2BucketEventNotificationSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		consts.AccountIDSchemaKey: {
+			Description: "The account identifier to target for the resource.",
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+		},
+		"bucket_name": {
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+			Description: "The name of the R2 bucket to send event notifications for.",
+		},
+		"queue_id": {
+			Type:         schema.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotWhiteSpace,
+			Description:  "The identifier of the queue event notifications are sent to.",
+		},
+		"queue_name": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The name of the queue event notifications are sent to.",
+		},
+		"rule": {
+			Type:        schema.TypeList,
+			Required:    true,
+			MinItems:    1,
+			Description: "Rules describing which events in the bucket are sent to the queue.",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"actions": {
+						Type:     schema.TypeSet,
+						Required: true,
+						MinItems: 1,
+						Elem: &schema.Schema{
+							Type:         schema.TypeString,
+							ValidateFunc: validation.StringInSlice(r2BucketEventNotificationActions, false),
+						},
+						Description: fmt.Sprintf("Event types which trigger a notification. %s", renderAvailableDocumentationValuesStringSlice(r2BucketEventNotificationActions)),
+					},
+					"prefix": {
+						Type:        schema.TypeString,
+						Optional:    true,
+						Description: "Only send notifications for objects with keys starting with this prefix.",
+					},
+					"suffix": {
+						Type:        schema.TypeString,
+						Optional:    true,
+						Description: "Only send notifications for objects with keys ending with this suffix.",
+					},
+					"description": {
+						Type:        schema.TypeString,
+						Optional:    true,
+						Description: "A description of the rule.",
+					},
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,109 @@
+package sdkv2provider
+
+import (
+	"fmt"
+
+	"github.com/cloudflare/terraform-provider-cloudflare/internal/consts"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+var r2BucketLockConditionTypes = []string{"Age", "Date"}
+
+func resourceCloudflareR2BucketLockSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		consts.AccountIDSchemaKey: {
+			Description: "The account identifier to target for the resource.",
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+		},
+		"bucket_name": {
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+			Description: "The name of the R2 bucket to configure retention rules for.",
+		},
+		"rules": {
+			Type:        schema.TypeList,
+			Required:    true,
+			MinItems:    1,
+			Description: "Retention rules preventing objects in the bucket from being deleted or overwritten.",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"id": {
+						Type:         schema.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringIsNotWhiteSpace,
+						Description:  "Unique identifier of the rule.",
+					},
+					"enabled": {
+						Type:        schema.TypeBool,
+						Optional:    true,
+						Default:     true,
+						Description: "Whether the rule is enforced.",
+					},
+					"prefix": {
+						Type:        schema.TypeString,
+						Optional:    true,
+						Description: "Only apply the rule to objects with keys starting with this prefix. Applies to all objects when not set.",
+					},
+					"condition": {
+						Type:        schema.TypeList,
+						Required:    true,
+						MaxItems:    1,
+						Description: "How long objects matching the rule are retained.",
+						Elem: &schema.Resource{
+							Schema: map[string]*schema.Schema{
+								"type": {
+									Type:         schema.TypeString,
+									Required:     true,
+									ValidateFunc: validation.StringInSlice(r2BucketLockConditionTypes, false),
+									Description:  fmt.Sprintf("Type of the retention condition. %s", renderAvailableDocumentationValuesStringSlice(r2BucketLockConditionTypes)),
+								},
+								"max_age_seconds": {
+									Type:         schema.TypeInt,
+									Optional:     true,
+									ValidateFunc: validation.IntAtLeast(1),
+									Description:  "Number of seconds objects are retained for after they are created. Required when `type` is `Age`.",
+								},
+								"date": {
+									Type:         schema.TypeString,
+									Optional:     true,
+									ValidateFunc: validation.IsRFC3339Time,
+									Description:  "Date until which objects are retained, in RFC 3339 format. Required when `type` is `Date`.",
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// validateR2BucketLockCondition checks that a retention condition sets the
+// field matching its type and none of the fields of the other types.
+func validateR2BucketLockCondition(condition map[string]interface{}) error {
+	maxAge := condition["max_age_seconds"].(int)
+	date := condition["date"].(string)
+
+	switch condition["type"].(string) {
+	case "Age":
+		if maxAge == 0 {
+			return fmt.Errorf("max_age_seconds must be set when type is %q", "Age")
+		}
+		if date != "" {
+			return fmt.Errorf("date cannot be set when type is %q", "Age")
+		}
+	case "Date":
+		if date == "" {
+			return fmt.Errorf("date must be set when type is %q", "Date")
+		}
+		if maxAge != 0 {
+			return fmt.Errorf("max_age_seconds cannot be set when type is %q", "Date")
+		}
+	}
+
+	return nil
+}
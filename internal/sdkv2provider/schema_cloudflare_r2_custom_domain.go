@@ -0,0 +1,63 @@
+package sdkv2provider
+
+import (
+	"fmt"
+
+	"github.com/cloudflare/terraform-provider-cloudflare/internal/consts"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+var r2CustomDomainMinTLSVersions = []string{"1.0", "1.1", "1.2", "1.3"}
+
+func resourceCloudflareR2CustomDomainSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		consts.AccountIDSchemaKey: {
+			Description: "The account identifier to target for the resource.",
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+		},
+		"bucket_name": {
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+			Description: "The name of the R2 bucket the domain serves.",
+		},
+		"domain": {
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+			Description: "The custom domain to connect to the bucket.",
+		},
+		consts.ZoneIDSchemaKey: {
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+			Description: "The zone identifier of the zone the domain belongs to.",
+		},
+		"enabled": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     true,
+			Description: "Whether the bucket is served over the domain.",
+		},
+		"min_tls": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Default:      "1.0",
+			ValidateFunc: validation.StringInSlice(r2CustomDomainMinTLSVersions, false),
+			Description:  fmt.Sprintf("Minimum TLS version accepted by the domain. %s", renderAvailableDocumentationValuesStringSlice(r2CustomDomainMinTLSVersions)),
+		},
+		"status": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Status of the SSL certificate of the domain.",
+		},
+		"ownership": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Status of the ownership verification of the domain.",
+		},
+	}
+}
@@ -0,0 +1,28 @@
+package sdkv2provider
+
+import (
+	"fmt"
+
+	"github.com/cloudflare/terraform-provider-cloudflare/internal/consts"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+var schemaValidationMitigationActions = []string{"none", "log", "block"}
+
+func resourceCloudflareSchemaValidationSettingsSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		consts.ZoneIDSchemaKey: {
+			Description: "The zone identifier to target for the resource.",
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+		},
+		"validation_default_mitigation_action": {
+			Description:  fmt.Sprintf("The default mitigation action used when there is no mitigation action defined on the operation. %s", renderAvailableDocumentationValuesStringSlice(schemaValidationMitigationActions)),
+			Type:         schema.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringInSlice(schemaValidationMitigationActions, false),
+		},
+	}
+}
@@ -0,0 +1,63 @@
+package sdkv2provider
+
+import (
+	"fmt"
+	"regexp"
+
+	"github.com/cloudflare/terraform-provider-cloudflare/internal/consts"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// snippetMaxContentSize is the largest snippet, in bytes, the API accepts.
+const snippetMaxContentSize = 32 * 1024
+
+func resourceCloudflareSnippetSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		consts.ZoneIDSchemaKey: {
+			Description: "The zone identifier to target for the resource.",
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+		},
+		"name": {
+			Type:         schema.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringMatch(regexp.MustCompile(`^[a-z0-9_]+$`), "must only contain lowercase letters, numbers and underscores"),
+			Description:  "The name of the snippet.",
+		},
+		"main_module": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Default:     "main.js",
+			Description: "The name of the module containing the snippet code.",
+		},
+		"content": {
+			Type:             schema.TypeString,
+			Required:         true,
+			ValidateFunc:     validateSnippetContentSize,
+			DiffSuppressFunc: suppressSnippetContentWhitespace,
+			Description:      fmt.Sprintf("The JavaScript module of the snippet, usually loaded with `file()`. Must not exceed %d bytes. Changes to trailing whitespace and line endings are ignored.", snippetMaxContentSize),
+		},
+		"content_sha256": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "SHA-256 hash of the snippet content deployed to Cloudflare, used to detect changes made outside of Terraform.",
+		},
+	}
+}
+
+func validateSnippetContentSize(v interface{}, k string) (ws []string, errs []error) {
+	value := v.(string)
+
+	if len(value) == 0 {
+		errs = append(errs, fmt.Errorf("%q must not be empty", k))
+	}
+
+	if len(value) > snippetMaxContentSize {
+		errs = append(errs, fmt.Errorf("%q is %d bytes which exceeds the snippet size limit of %d bytes", k, len(value), snippetMaxContentSize))
+	}
+
+	return
+}
@@ -0,0 +1,75 @@
+package sdkv2provider
+
+import (
+	"fmt"
+
+	"github.com/cloudflare/terraform-provider-cloudflare/internal/consts"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+var vectorizeIndexMetrics = []string{"cosine", "euclidean", "dot-product"}
+
+const (
+	vectorizeIndexMinDimensions = 1
+	vectorizeIndexMaxDimensions = 1536
+)
+
+func resourceCloudflareVectorizeIndexSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		consts.AccountIDSchemaKey: {
+			Description: "The account identifier to target for the resource.",
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+		},
+		"name": {
+			Type:         schema.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringLenBetween(1, 64),
+			Description:  "The name of the index.",
+		},
+		"description": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			ForceNew:    true,
+			Description: "A description of the index.",
+		},
+		"config": {
+			Type:        schema.TypeList,
+			Required:    true,
+			ForceNew:    true,
+			MaxItems:    1,
+			Description: "The configuration of the vectors stored in the index.",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"dimensions": {
+						Type:         schema.TypeInt,
+						Required:     true,
+						ForceNew:     true,
+						ValidateFunc: validation.IntBetween(vectorizeIndexMinDimensions, vectorizeIndexMaxDimensions),
+						Description:  fmt.Sprintf("The number of dimensions of the vectors. Must be between `%d` and `%d`.", vectorizeIndexMinDimensions, vectorizeIndexMaxDimensions),
+					},
+					"metric": {
+						Type:         schema.TypeString,
+						Required:     true,
+						ForceNew:     true,
+						ValidateFunc: validation.StringInSlice(vectorizeIndexMetrics, false),
+						Description:  fmt.Sprintf("The distance metric used to compare vectors. %s", renderAvailableDocumentationValuesStringSlice(vectorizeIndexMetrics)),
+					},
+				},
+			},
+		},
+		"created_on": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "When the index was created.",
+		},
+		"modified_on": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "When the index was last modified.",
+		},
+	}
+}
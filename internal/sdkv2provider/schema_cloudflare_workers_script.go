@@ -1,8 +1,14 @@
 package sdkv2provider
 
 import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
 	"github.com/cloudflare/terraform-provider-cloudflare/internal/consts"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 var kvNamespaceBindingResource = &schema.Resource{
@@ -116,6 +122,96 @@ var analyticsEngineBindingResource = &schema.Resource{
 	},
 }
 
+var observabilityResource = &schema.Resource{
+	Schema: map[string]*schema.Schema{
+		"enabled": {
+			Type:        schema.TypeBool,
+			Required:    true,
+			Description: "Whether Workers Logs are collected for the script.",
+		},
+		"head_sampling_rate": {
+			Type:         schema.TypeFloat,
+			Optional:     true,
+			Default:      1,
+			ValidateFunc: validation.FloatBetween(0, 1),
+			Description:  "The fraction of requests to collect logs for, between `0` and `1`.",
+		},
+	},
+}
+
+var tailConsumerResource = &schema.Resource{
+	Schema: map[string]*schema.Schema{
+		"service": {
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "The name of the Worker receiving the tail events.",
+		},
+		"environment": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "The name of the Worker environment receiving the tail events.",
+		},
+		"namespace": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "The Workers for Platforms dispatch namespace of the Worker receiving the tail events.",
+		},
+	},
+}
+
+// workerScriptOpposingCompatibilityFlags pairs compatibility flags which
+// enable and disable the same runtime behaviour but aren't named after each
+// other with a "no_" prefix.
+var workerScriptOpposingCompatibilityFlags = map[string]string{
+	"export_commonjs_default":         "export_commonjs_namespace",
+	"fetch_refuses_unknown_protocols": "fetch_treats_unknown_protocols_as_http",
+	"formdata_parser_supports_files":  "formdata_parser_converts_files_to_strings",
+	"streams_enable_constructors":     "streams_disable_constructors",
+	"url_standard":                    "url_original",
+}
+
+// validateWorkerScriptCompatibilityDate ensures the compatibility date is a
+// YYYY-MM-DD date which isn't in the future, as the runtime rejects scripts
+// asking for behaviour it doesn't know about yet.
+func validateWorkerScriptCompatibilityDate(v interface{}, k string) (ws []string, errs []error) {
+	value := v.(string)
+
+	date, err := time.Parse("2006-01-02", value)
+	if err != nil {
+		errs = append(errs, fmt.Errorf("%q must be a date in the format YYYY-MM-DD, got: %s", k, value))
+		return
+	}
+
+	if date.After(time.Now().UTC()) {
+		errs = append(errs, fmt.Errorf("%q must not be in the future, got: %s", k, value))
+	}
+
+	return
+}
+
+// conflictingWorkerScriptCompatibilityFlags returns the pairs of flags which
+// both enable and disable the same runtime behaviour.
+func conflictingWorkerScriptCompatibilityFlags(flags []string) [][2]string {
+	enabled := make(map[string]bool, len(flags))
+	for _, flag := range flags {
+		enabled[flag] = true
+	}
+
+	conflicts := make([][2]string, 0)
+	for _, flag := range flags {
+		if strings.HasPrefix(flag, "no_") && enabled[strings.TrimPrefix(flag, "no_")] {
+			conflicts = append(conflicts, [2]string{strings.TrimPrefix(flag, "no_"), flag})
+		}
+		if opposite, ok := workerScriptOpposingCompatibilityFlags[flag]; ok && enabled[opposite] {
+			conflicts = append(conflicts, [2]string{flag, opposite})
+		}
+	}
+
+	sort.Slice(conflicts, func(i, j int) bool { return conflicts[i][0] < conflicts[j][0] })
+
+	return conflicts
+}
+
 func resourceCloudflareWorkerScriptSchema() map[string]*schema.Schema {
 	return map[string]*schema.Schema{
 		consts.AccountIDSchemaKey: {
@@ -139,6 +235,20 @@ func resourceCloudflareWorkerScriptSchema() map[string]*schema.Schema {
 			Optional:    true,
 			Description: "Whether to upload Worker as a module.",
 		},
+		"compatibility_date": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Computed:     true,
+			ValidateFunc: validateWorkerScriptCompatibilityDate,
+			Description:  "The date in the format `YYYY-MM-DD` used to determine which version of the Workers runtime is used. Must not be in the future.",
+		},
+		"compatibility_flags": {
+			Type:        schema.TypeSet,
+			Optional:    true,
+			Computed:    true,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Description: "Compatibility flags used to enable or disable features of the Workers runtime. A warning is shown for flags which both enable and disable the same feature.",
+		},
 		"plain_text_binding": {
 			Type:     schema.TypeSet,
 			Optional: true,
@@ -174,5 +284,18 @@ func resourceCloudflareWorkerScriptSchema() map[string]*schema.Schema {
 			Optional: true,
 			Elem:     analyticsEngineBindingResource,
 		},
+		"observability": {
+			Type:        schema.TypeList,
+			Optional:    true,
+			MaxItems:    1,
+			Elem:        observabilityResource,
+			Description: "Workers Logs settings of the script. Removing the block turns Workers Logs off.",
+		},
+		"tail_consumers": {
+			Type:        schema.TypeSet,
+			Optional:    true,
+			Elem:        tailConsumerResource,
+			Description: "Workers which receive the tail events of the script.",
+		},
 	}
 }
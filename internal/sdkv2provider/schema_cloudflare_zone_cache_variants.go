@@ -2,20 +2,45 @@ package sdkv2provider
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/cloudflare/terraform-provider-cloudflare/internal/consts"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
+// zoneCacheVariantsExtensions are the file extensions which can have variants.
+var zoneCacheVariantsExtensions = []string{"avif", "bmp", "gif", "jpeg", "jpg", "jpg2", "jp2", "png", "tiff", "tif", "webp"}
+
+// zoneCacheVariantsRecommendedDefaults are the variants applied to each
+// extension left unset when `use_recommended_defaults` is enabled. Extensions
+// without a registered MIME type have no recommended variants.
+var zoneCacheVariantsRecommendedDefaults = map[string][]string{
+	"avif": {"image/avif", "image/webp"},
+	"bmp":  {"image/bmp", "image/webp"},
+	"gif":  {"image/gif", "image/webp"},
+	"jpeg": {"image/jpeg", "image/webp"},
+	"jpg":  {"image/jpeg", "image/webp"},
+	"png":  {"image/png", "image/webp"},
+	"tiff": {"image/tiff", "image/webp"},
+	"tif":  {"image/tiff", "image/webp"},
+	"webp": {"image/jpeg", "image/webp"},
+}
+
 func resourceCloudflareZoneCacheVariantsExtensionSchema(ext string) *schema.Schema {
+	description := fmt.Sprintf("List of strings with the MIME types of all the variants that should be served for %s.", ext)
+	if defaults, ok := zoneCacheVariantsRecommendedDefaults[ext]; ok {
+		description += fmt.Sprintf(" Defaults to `%s` when `use_recommended_defaults` is `true`.", strings.Join(defaults, "`, `"))
+	}
+
 	return &schema.Schema{
 		MinItems: 1,
 		Optional: true,
+		Computed: true,
 		Type:     schema.TypeSet,
 		Elem: &schema.Schema{
 			Type: schema.TypeString,
 		},
-		Description: fmt.Sprintf("List of strings with the MIME types of all the variants that should be served for %s", ext),
+		Description: description,
 	}
 }
 
@@ -27,6 +52,12 @@ func resourceCloudflareZoneCacheVariantsSchema() map[string]*schema.Schema {
 			Required:    true,
 			ForceNew:    true,
 		},
+		"use_recommended_defaults": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+			Description: "Whether to apply the recommended variants to every extension that isn't explicitly configured.",
+		},
 		"avif": resourceCloudflareZoneCacheVariantsExtensionSchema("avif"),
 		"bmp":  resourceCloudflareZoneCacheVariantsExtensionSchema("bmp"),
 		"gif":  resourceCloudflareZoneCacheVariantsExtensionSchema("gif"),
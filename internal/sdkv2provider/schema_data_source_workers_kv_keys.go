@@ -0,0 +1,55 @@
+package sdkv2provider
+
+import (
+	"github.com/cloudflare/terraform-provider-cloudflare/internal/consts"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceCloudflareWorkersKVKeys() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceCloudflareWorkersKVKeysRead,
+
+		Schema: map[string]*schema.Schema{
+			consts.AccountIDSchemaKey: {
+				Description: "The account identifier to target for the resource.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"namespace_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The ID of the Workers KV namespace to list keys of.",
+			},
+			"prefix": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only return keys starting with this prefix.",
+			},
+			"keys": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The keys stored in the namespace.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The name of the key.",
+						},
+						"expiration": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The time, measured in seconds since the UNIX epoch, at which the key will expire. `0` if the key does not expire.",
+						},
+						"metadata": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "JSON encoded metadata stored alongside the key.",
+						},
+					},
+				},
+			},
+		},
+		Description: "Use this data source to list the keys stored in a Workers KV namespace.",
+	}
+}
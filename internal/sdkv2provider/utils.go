@@ -268,3 +268,54 @@ func renderAvailableDocumentationValuesIntSlice(s []int) string {
 	}
 	return output
 }
+
+// validateExpressionSyntax catches malformed rules language expressions
+// before they are sent to the API: blank expressions, unbalanced brackets and
+// unterminated string literals.
+func validateExpressionSyntax(v interface{}, k string) (ws []string, errs []error) {
+	expression := v.(string)
+
+	if strings.TrimSpace(expression) == "" {
+		errs = append(errs, fmt.Errorf("%q must not be empty", k))
+		return
+	}
+
+	pairs := map[rune]rune{')': '(', ']': '['}
+	var stack []rune
+	inString, escaped := false, false
+
+	for _, c := range expression {
+		if inString {
+			switch {
+			case escaped:
+				escaped = false
+			case c == '\\':
+				escaped = true
+			case c == '"':
+				inString = false
+			}
+			continue
+		}
+
+		switch c {
+		case '"':
+			inString = true
+		case '(', '[':
+			stack = append(stack, c)
+		case ')', ']':
+			if len(stack) == 0 || stack[len(stack)-1] != pairs[c] {
+				errs = append(errs, fmt.Errorf("%q has an unexpected %q in expression %q", k, c, expression))
+				return
+			}
+			stack = stack[:len(stack)-1]
+		}
+	}
+
+	if inString {
+		errs = append(errs, fmt.Errorf("%q has an unterminated string in expression %q", k, expression))
+	} else if len(stack) > 0 {
+		errs = append(errs, fmt.Errorf("%q has an unclosed %q in expression %q", k, stack[len(stack)-1], expression))
+	}
+
+	return
+}